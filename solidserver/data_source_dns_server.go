@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
 	"net/url"
-	"regexp"
 	"strings"
 )
 
@@ -60,7 +59,7 @@ func dataSourcednsserver() *schema.Resource {
 			},
 			"allow_transfer": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS server for zone transfert (named ACL(s) are not supported using this provider).",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS server for zone transfert.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -68,7 +67,7 @@ func dataSourcednsserver() *schema.Resource {
 			},
 			"allow_query": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS server (named ACL(s) are not supported using this provider).",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS server.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -76,7 +75,7 @@ func dataSourcednsserver() *schema.Resource {
 			},
 			"allow_recursion": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS server for recursion (named ACL(s) are not supported using this provider).",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS server for recursion.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -145,7 +144,7 @@ func dataSourcednsserverRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_transfer"].(string) != "" {
 				allowTransfers := []string{}
 				for _, allowTransfer := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_transfer"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer.(string)); match == true {
+					if match, _ := dnsaclmatch(allowTransfer.(string)); match == true {
 						allowTransfers = append(allowTransfers, allowTransfer.(string))
 					}
 				}
@@ -156,7 +155,7 @@ func dataSourcednsserverRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_query"].(string) != "" {
 				allowQueries := []string{}
 				for _, allowQuery := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_query"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery.(string)); match == true {
+					if match, _ := dnsaclmatch(allowQuery.(string)); match == true {
 						allowQueries = append(allowQueries, allowQuery.(string))
 					}
 				}
@@ -167,7 +166,7 @@ func dataSourcednsserverRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_recursion"].(string) != "" {
 				allowRecursions := []string{}
 				for _, allowRecursion := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_recursion"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion.(string)); match == true {
+					if match, _ := dnsaclmatch(allowRecursion.(string)); match == true {
 						allowRecursions = append(allowRecursions, allowRecursion.(string))
 					}
 				}
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
 	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 )
@@ -51,7 +50,7 @@ func dataSourcednsview() *schema.Resource {
 			},
 			"allow_transfer": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS view for zone transfert (named ACL(s) are not supported using this provider).",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS view for zone transfert.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -59,7 +58,7 @@ func dataSourcednsview() *schema.Resource {
 			},
 			"allow_query": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS view (named ACL(s) are not supported using this provider).",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS view.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -67,7 +66,7 @@ func dataSourcednsview() *schema.Resource {
 			},
 			"allow_recursion": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS view for recursion (named ACL(s) are not supported using this provider).",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS view for recursion.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -75,7 +74,7 @@ func dataSourcednsview() *schema.Resource {
 			},
 			"match_clients": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes used to match the clients of the view (named ACL(s) are not supported using this provider).",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs used to match the clients of the view.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -83,7 +82,7 @@ func dataSourcednsview() *schema.Resource {
 			},
 			"match_to": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes used to match the traffic to the view (named ACL(s) are not supported using this provider).",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs used to match the traffic to the view.",
 				Computed:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -137,7 +136,7 @@ func dataSourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			// Updating forward mode
-			forward, forwardErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "forward", meta)
+			forward, forwardErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "", "forward", meta)
 			if forwardErr == nil {
 				if forward == "" {
 					d.Set("forward", "none")
@@ -150,7 +149,7 @@ func dataSourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			// Updating forwarder information
-			forwarders, forwardersErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "forwarders", meta)
+			forwarders, forwardersErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "", "forwarders", meta)
 			if forwardersErr == nil {
 				if forwarders != "" {
 					d.Set("forwarders", toStringArrayInterface(strings.Split(strings.TrimSuffix(forwarders, ";"), ";")))
@@ -165,7 +164,7 @@ func dataSourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_allow_transfer"].(string) != "" {
 				allowTransfers := []string{}
 				for _, allowTransfer := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_transfer"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer.(string)); match == true {
+					if match, _ := dnsaclmatch(allowTransfer.(string)); match == true {
 						allowTransfers = append(allowTransfers, allowTransfer.(string))
 					}
 				}
@@ -176,7 +175,7 @@ func dataSourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_allow_query"].(string) != "" {
 				allowQueries := []string{}
 				for _, allowQuery := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_query"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery.(string)); match == true {
+					if match, _ := dnsaclmatch(allowQuery.(string)); match == true {
 						allowQueries = append(allowQueries, allowQuery.(string))
 					}
 				}
@@ -187,7 +186,7 @@ func dataSourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_allow_recursion"].(string) != "" {
 				allowRecursions := []string{}
 				for _, allowRecursion := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_recursion"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion.(string)); match == true {
+					if match, _ := dnsaclmatch(allowRecursion.(string)); match == true {
 						allowRecursions = append(allowRecursions, allowRecursion.(string))
 					}
 				}
@@ -198,7 +197,7 @@ func dataSourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_match_clients"].(string) != "" {
 				matchClients := []string{}
 				for _, matchClient := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_match_clients"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, matchClient.(string)); match == true {
+					if match, _ := dnsaclmatch(matchClient.(string)); match == true {
 						matchClients = append(matchClients, matchClient.(string))
 					}
 				}
@@ -208,7 +207,7 @@ func dataSourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_match_to"].(string) != "" {
 				matchTos := []string{}
 				for _, matchTo := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_match_to"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, matchTo.(string)); match == true {
+					if match, _ := dnsaclmatch(matchTo.(string)); match == true {
 						matchTos = append(matchTos, matchTo.(string))
 					}
 				}
@@ -0,0 +1,203 @@
+package solidserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+func dataSourceip6addresses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceip6addressesRead,
+
+		Schema: map[string]*schema.Schema{
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of the space of the IPv6 addresses.",
+				Required:    true,
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Description: "The name of the subnet of the IPv6 addresses.",
+				Optional:    true,
+				Default:     "",
+			},
+			"pool": {
+				Type:        schema.TypeString,
+				Description: "The name of the pool of the IPv6 addresses.",
+				Optional:    true,
+				Default:     "",
+			},
+			"query": {
+				Type:        schema.TypeString,
+				Description: "An additional query used to filter the IPv6 addresses.",
+				Optional:    true,
+				Default:     "",
+			},
+			"tags": {
+				Type:        schema.TypeString,
+				Description: "The tags to be used to filter the IPv6 addresses in the query.",
+				Optional:    true,
+				Default:     "",
+			},
+			"orderby": {
+				Type:        schema.TypeString,
+				Description: "The clause used to order the IPv6 addresses.",
+				Optional:    true,
+				Default:     "",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Description: "The list of used IPv6 addresses.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Description: "The IPv6 address.",
+							Computed:    true,
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Description: "The name of the subnet of the IPv6 address.",
+							Computed:    true,
+						},
+						"pool": {
+							Type:        schema.TypeString,
+							Description: "The name of the pool of the IPv6 address.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The short name or FQDN of the IPv6 address.",
+							Computed:    true,
+						},
+						"mac": {
+							Type:        schema.TypeString,
+							Description: "The MAC Address of the IPv6 address.",
+							Computed:    true,
+						},
+						"class": {
+							Type:        schema.TypeString,
+							Description: "The class associated to the IPv6 address.",
+							Computed:    true,
+						},
+						"class_parameters": {
+							Type:        schema.TypeMap,
+							Description: "The class parameters associated to the IPv6 address.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceip6addressesRead(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+	d.SetId("")
+
+	whereClause := "site_name='" + d.Get("space").(string) + "' AND type!='free'"
+
+	if d.Get("subnet").(string) != "" {
+		whereClause += " AND subnet6_name='" + d.Get("subnet").(string) + "'"
+	}
+
+	if d.Get("pool").(string) != "" {
+		whereClause += " AND pool6_name='" + d.Get("pool").(string) + "'"
+	}
+
+	if d.Get("query").(string) != "" {
+		whereClause += " AND (" + d.Get("query").(string) + ")"
+	}
+
+	addresses := make([]interface{}, 0)
+	offset := 0
+
+	for {
+		// Building parameters
+		parameters := url.Values{}
+		parameters.Add("TAGS", d.Get("tags").(string))
+		parameters.Add("WHERE", whereClause)
+		parameters.Add("ORDERBY", d.Get("orderby").(string))
+		parameters.Add("offset", strconv.Itoa(offset))
+		parameters.Add("limit", strconv.Itoa(listPageSize))
+
+		// Sending the read request
+		resp, body, err := s.Request("get", "rest/ip6_address6_list", &parameters)
+
+		if err != nil {
+			// Reporting a failure
+			return err
+		}
+
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					// Log the error
+					log.Printf("[DEBUG] SOLIDServer - Unable to list IPv6 addresses in space: %s (%s)\n", d.Get("space").(string), errMsg)
+				}
+			}
+
+			// Reporting a failure
+			return fmt.Errorf("SOLIDServer - Unable to list IPv6 addresses in space: %s", d.Get("space").(string))
+		}
+
+		for _, info := range buf {
+			address := map[string]interface{}{}
+
+			address["address"] = hexip6toip6(info["ip6_addr"].(string))
+			address["subnet"], _ = info["subnet6_name"].(string)
+			address["pool"], _ = info["pool6_name"].(string)
+			address["name"], _ = info["ip6_name"].(string)
+			address["mac"] = ""
+
+			if mac, macExist := info["ip6_mac_addr"].(string); macExist {
+				if macIgnore, _ := regexp.MatchString("^EIP:", mac); !macIgnore {
+					address["mac"] = mac
+				}
+			}
+
+			address["class"], _ = info["ip6_class_name"].(string)
+
+			// Building class_parameters
+			classParameters, _ := info["ip6_class_parameters"].(string)
+			retrievedClassParameters, _ := url.ParseQuery(classParameters)
+			computedClassParameters := map[string]interface{}{}
+
+			for ck := range retrievedClassParameters {
+				if ck != "gateway" {
+					computedClassParameters[ck] = retrievedClassParameters[ck][0]
+				}
+			}
+
+			address["class_parameters"] = computedClassParameters
+
+			addresses = append(addresses, address)
+		}
+
+		if len(buf) < listPageSize {
+			break
+		}
+
+		offset += listPageSize
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String("ip6_address6_list:" + whereClause)))
+	d.Set("addresses", addresses)
+
+	return nil
+}
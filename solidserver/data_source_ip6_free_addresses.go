@@ -0,0 +1,105 @@
+package solidserver
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"log"
+)
+
+func dataSourceip6freeaddresses() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceip6freeaddressesRead,
+
+		Schema: map[string]*schema.Schema{
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of the space into which looking for free IPv6 addresses.",
+				Required:    true,
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Description: "The name of the subnet into which looking for free IPv6 addresses.",
+				Required:    true,
+			},
+			"pool": {
+				Type:        schema.TypeString,
+				Description: "The name of the pool into which looking for free IPv6 addresses.",
+				Optional:    true,
+				Default:     "",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Description:  "The number of free IPv6 addresses to return (Supported: 1 to 32; Default: 1).",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Description: "The list of free IPv6 addresses.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceip6freeaddressesRead(d *schema.ResourceData, meta interface{}) error {
+	var poolID string = ""
+	d.SetId("")
+
+	// Gather required ID(s) from provided information
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteErr != nil {
+		// Reporting a failure
+		return siteErr
+	}
+
+	subnetInfo, subnetErr := ip6subnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
+	if subnetInfo == nil || subnetErr != nil {
+		if subnetErr != nil {
+			// Reporting a failure
+			return subnetErr
+		}
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find IPv6 subnet: %s", d.Get("subnet").(string))
+	}
+
+	if len(d.Get("pool").(string)) > 0 {
+		poolInfo, poolErr := ip6poolinfobyname(siteID, d.Get("pool").(string), d.Get("subnet").(string), meta)
+		if poolInfo == nil || poolErr != nil {
+			if poolErr != nil {
+				// Reporting a failure
+				return poolErr
+			}
+
+			// Reporting a failure
+			return fmt.Errorf("SOLIDServer - Unable to find IPv6 pool: %s", d.Get("pool").(string))
+		}
+
+		poolID = poolInfo["id"].(string)
+	}
+
+	ipAddresses, ipErr := ip6addressfindfree(subnetInfo["id"].(string), poolID, meta)
+	if ipErr != nil {
+		// Reporting a failure
+		return ipErr
+	}
+
+	if len(ipAddresses) > d.Get("limit").(int) {
+		ipAddresses = ipAddresses[:d.Get("limit").(int)]
+	}
+
+	if len(ipAddresses) == 0 {
+		log.Printf("[DEBUG] SOLIDServer - No free IPv6 address found in subnet: %s\n", d.Get("subnet").(string))
+	}
+
+	d.SetId(subnetInfo["id"].(string) + "/" + poolID)
+	d.Set("addresses", toStringArrayInterface(ipAddresses))
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package solidserver
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"log"
+	"strconv"
+)
+
+func dataSourceip6freesubnets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceip6freesubnetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of the space into which looking for free IPv6 subnets.",
+				Required:    true,
+			},
+			"block": {
+				Type:        schema.TypeString,
+				Description: "The name of the block into which looking for free IPv6 subnets.",
+				Required:    true,
+			},
+			"prefix_size": {
+				Type:         schema.TypeInt,
+				Description:  "The expected IPv6 subnets' prefix length (ex: 64 for a '/64').",
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 128),
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Description:  "The number of free IPv6 subnets to return (Supported: 1 to 16; Default: 1).",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 16),
+			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Description: "The list of free IPv6 subnets' prefixes.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceip6freesubnetsRead(d *schema.ResourceData, meta interface{}) error {
+	subnets := []string{}
+	d.SetId("")
+
+	// Gather required ID(s) from provided information
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteErr != nil {
+		// Reporting a failure
+		return siteErr
+	}
+
+	blockInfo, blockErr := ip6subnetinfobyname(siteID, d.Get("block").(string), false, meta)
+	if blockInfo == nil || blockErr != nil {
+		if blockErr != nil {
+			// Reporting a failure
+			return blockErr
+		}
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find IPv6 block: %s", d.Get("block").(string))
+	}
+
+	subnetAddresses, subnetErr := ip6subnetfindbysize(siteID, blockInfo["id"].(string), "", d.Get("prefix_size").(int), meta)
+	if subnetErr != nil {
+		// Reporting a failure
+		return subnetErr
+	}
+
+	for i := 0; i < len(subnetAddresses) && i < d.Get("limit").(int); i++ {
+		subnets = append(subnets, hexip6toip6(subnetAddresses[i])+"/"+strconv.Itoa(d.Get("prefix_size").(int)))
+	}
+
+	if len(subnets) == 0 {
+		log.Printf("[DEBUG] SOLIDServer - No free IPv6 subnet found in block: %s\n", d.Get("block").(string))
+	}
+
+	d.SetId(blockInfo["id"].(string) + "/" + strconv.Itoa(d.Get("prefix_size").(int)))
+	d.Set("subnets", toStringArrayInterface(subnets))
+
+	return nil
+}
@@ -49,6 +49,21 @@ func dataSourceip6subnet() *schema.Resource {
 				Description: "The  IPv6 subnet's computed gateway.",
 				Computed:    true,
 			},
+			"vlan_domain": {
+				Type:        schema.TypeString,
+				Description: "The vlan domain's name of the vlan associated to the IPv6 subnet.",
+				Computed:    true,
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Description: "The vlan ID associated to the IPv6 subnet.",
+				Computed:    true,
+			},
+			"vlan_name": {
+				Type:        schema.TypeString,
+				Description: "The vlan name associated to the IPv6 subnet.",
+				Computed:    true,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IPv6 subnet.",
@@ -103,6 +118,7 @@ func dataSourceip6subnetRead(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			d.Set("class", buf[0]["subnet6_class_name"].(string))
+			subnetvlanset(d, buf[0])
 
 			// Setting local class_parameters
 			retrievedClassParameters, _ := url.ParseQuery(buf[0]["subnet6_class_parameters"].(string))
@@ -0,0 +1,134 @@
+package solidserver
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"math/big"
+	"strings"
+)
+
+func dataSourceip6utilization() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceip6utilizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of the space to compute the IPv6 utilization of.",
+				Required:    true,
+			},
+			"block": {
+				Type:          schema.TypeString,
+				Description:   "The name of the IPv6 block to compute the utilization of.",
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{"subnet"},
+			},
+			"subnet": {
+				Type:          schema.TypeString,
+				Description:   "The name of the IPv6 subnet to compute the utilization of.",
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{"block"},
+			},
+			"size": {
+				Type:        schema.TypeString,
+				Description: "The number of IPv6 addresses within the space, block or subnet (as a decimal string).",
+				Computed:    true,
+			},
+			"used": {
+				Type:        schema.TypeInt,
+				Description: "The number of used IPv6 addresses within the space, block or subnet.",
+				Computed:    true,
+			},
+			"free": {
+				Type:        schema.TypeString,
+				Description: "The number of free IPv6 addresses within the space, block or subnet (as a decimal string).",
+				Computed:    true,
+			},
+			"reserved": {
+				Type:        schema.TypeInt,
+				Description: "The number of reserved IPv6 addresses (subnet-router anycast addresses of the terminal subnets).",
+				Computed:    true,
+			},
+			"used_percent": {
+				Type:        schema.TypeFloat,
+				Description: "The percentage of used IPv6 addresses among the allocatable ones.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceip6utilizationRead(d *schema.ResourceData, meta interface{}) error {
+	var subnetInfo map[string]interface{} = nil
+	var subnetErr error = nil
+	var startHexAddr string = strings.Repeat("0", 32)
+	var endHexAddr string = strings.Repeat("f", 32)
+	var size *big.Int = nil
+	d.SetId("")
+
+	// Gather required ID(s) from provided information
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteErr != nil {
+		// Reporting a failure
+		return siteErr
+	}
+
+	if len(d.Get("subnet").(string)) > 0 {
+		subnetInfo, subnetErr = ip6subnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
+	} else if len(d.Get("block").(string)) > 0 {
+		subnetInfo, subnetErr = ip6subnetinfobyname(siteID, d.Get("block").(string), false, meta)
+	}
+
+	if subnetErr != nil {
+		// Reporting a failure
+		return subnetErr
+	}
+
+	if subnetInfo != nil {
+		startHexAddr = subnetInfo["start_hex_addr"].(string)
+		endHexAddr = subnetInfo["end_hex_addr"].(string)
+		size = new(big.Int).Lsh(big.NewInt(1), uint(128-subnetInfo["prefix_length"].(int)))
+	} else if len(d.Get("subnet").(string)) > 0 || len(d.Get("block").(string)) > 0 {
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find IPv6 subnet: %s%s", d.Get("subnet").(string), d.Get("block").(string))
+	} else {
+		var sizeErr error = nil
+
+		if size, sizeErr = ip6spacesize(siteID, meta); sizeErr != nil {
+			// Reporting a failure
+			return sizeErr
+		}
+	}
+
+	used := ip6addresscountinrange(siteID, startHexAddr, endHexAddr, meta)
+	terminals := ip6subnetcountterminalinrange(siteID, startHexAddr, endHexAddr, meta)
+
+	if used < 0 || terminals < 0 {
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to compute the IPv6 utilization of space: %s", d.Get("space").(string))
+	}
+
+	// The subnet-router anycast address of each terminal subnet can't be allocated
+	reserved := terminals
+
+	free := new(big.Int).Sub(size, big.NewInt(int64(used+reserved)))
+	if free.Sign() < 0 {
+		free = big.NewInt(0)
+	}
+
+	if subnetInfo != nil {
+		d.SetId(subnetInfo["id"].(string))
+	} else {
+		d.SetId(siteID)
+	}
+
+	d.Set("size", BigIntToStr(size))
+	d.Set("used", used)
+	d.Set("free", BigIntToStr(free))
+	d.Set("reserved", reserved)
+	d.Set("used_percent", ipusagepercent(size, int64(used), int64(reserved)))
+
+	return nil
+}
@@ -18,6 +18,11 @@ func dataSourceipspace() *schema.Resource {
 				Description: "The name of the IP space.",
 				Required:    true,
 			},
+			"parent_space": {
+				Type:        schema.TypeString,
+				Description: "The name of the parent IP space of the IP space (VLSM).",
+				Computed:    true,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IP space.",
@@ -54,6 +59,10 @@ func dataSourceipspaceRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("name", buf[0]["site_name"].(string))
 			d.Set("class", buf[0]["site_class_name"].(string))
 
+			if parentSiteName, parentSiteNameExist := buf[0]["parent_site_name"].(string); parentSiteNameExist {
+				d.Set("parent_space", parentSiteName)
+			}
+
 			// Updating local class_parameters
 			retrievedClassParameters, _ := url.ParseQuery(buf[0]["site_class_parameters"].(string))
 			computedClassParameters := map[string]string{}
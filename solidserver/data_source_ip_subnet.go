@@ -54,6 +54,21 @@ func dataSourceipsubnet() *schema.Resource {
 				Description: "The subnet's computed gateway.",
 				Computed:    true,
 			},
+			"vlan_domain": {
+				Type:        schema.TypeString,
+				Description: "The vlan domain's name of the vlan associated to the IP subnet.",
+				Computed:    true,
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Description: "The vlan ID associated to the IP subnet.",
+				Computed:    true,
+			},
+			"vlan_name": {
+				Type:        schema.TypeString,
+				Description: "The vlan name associated to the IP subnet.",
+				Computed:    true,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IP subnet.",
@@ -111,6 +126,7 @@ func dataSourceipsubnetRead(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			d.Set("class", buf[0]["subnet_class_name"].(string))
+			subnetvlanset(d, buf[0])
 
 			// Setting local class_parameters
 			retrievedClassParameters, _ := url.ParseQuery(buf[0]["subnet_class_parameters"].(string))
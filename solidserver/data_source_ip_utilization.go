@@ -0,0 +1,136 @@
+package solidserver
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"math/big"
+)
+
+func dataSourceiputilization() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceiputilizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of the space to compute the utilization of.",
+				Required:    true,
+			},
+			"block": {
+				Type:          schema.TypeString,
+				Description:   "The name of the block to compute the utilization of.",
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{"subnet"},
+			},
+			"subnet": {
+				Type:          schema.TypeString,
+				Description:   "The name of the subnet to compute the utilization of.",
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{"block"},
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Description: "The number of IP addresses within the space, block or subnet.",
+				Computed:    true,
+			},
+			"used": {
+				Type:        schema.TypeInt,
+				Description: "The number of used IP addresses within the space, block or subnet.",
+				Computed:    true,
+			},
+			"free": {
+				Type:        schema.TypeInt,
+				Description: "The number of free IP addresses within the space, block or subnet.",
+				Computed:    true,
+			},
+			"reserved": {
+				Type:        schema.TypeInt,
+				Description: "The number of reserved IP addresses (network and broadcast addresses of the terminal subnets).",
+				Computed:    true,
+			},
+			"used_percent": {
+				Type:        schema.TypeFloat,
+				Description: "The percentage of used IP addresses among the allocatable ones.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceiputilizationRead(d *schema.ResourceData, meta interface{}) error {
+	var subnetInfo map[string]interface{} = nil
+	var subnetErr error = nil
+	var startHexAddr string = "00000000"
+	var endHexAddr string = "ffffffff"
+	var size int = 0
+	d.SetId("")
+
+	// Gather required ID(s) from provided information
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteErr != nil {
+		// Reporting a failure
+		return siteErr
+	}
+
+	if len(d.Get("subnet").(string)) > 0 {
+		subnetInfo, subnetErr = ipsubnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
+	} else if len(d.Get("block").(string)) > 0 {
+		subnetInfo, subnetErr = ipsubnetinfobyname(siteID, d.Get("block").(string), false, meta)
+	}
+
+	if subnetErr != nil {
+		// Reporting a failure
+		return subnetErr
+	}
+
+	if subnetInfo != nil {
+		startHexAddr = subnetInfo["start_hex_addr"].(string)
+		endHexAddr = subnetInfo["end_hex_addr"].(string)
+		size = subnetInfo["size"].(int)
+	} else if len(d.Get("subnet").(string)) > 0 || len(d.Get("block").(string)) > 0 {
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find IP subnet: %s%s", d.Get("subnet").(string), d.Get("block").(string))
+	} else {
+		var sizeErr error = nil
+
+		if size, sizeErr = ipspacesize(siteID, meta); sizeErr != nil {
+			// Reporting a failure
+			return sizeErr
+		}
+	}
+
+	used := ipaddresscountinrange(siteID, startHexAddr, endHexAddr, meta)
+	terminals := ipsubnetcountterminalinrange(siteID, startHexAddr, endHexAddr, meta)
+
+	if used < 0 || terminals < 0 {
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to compute the utilization of space: %s", d.Get("space").(string))
+	}
+
+	// Network and broadcast addresses of each terminal subnet can't be allocated
+	reserved := 2 * terminals
+	if size <= 2 {
+		reserved = 0
+	}
+
+	free := size - used - reserved
+	if free < 0 {
+		free = 0
+	}
+
+	if subnetInfo != nil {
+		d.SetId(subnetInfo["id"].(string))
+	} else {
+		d.SetId(siteID)
+	}
+
+	d.Set("size", size)
+	d.Set("used", used)
+	d.Set("free", free)
+	d.Set("reserved", reserved)
+	d.Set("used_percent", ipusagepercent(big.NewInt(int64(size)), int64(used), int64(reserved)))
+
+	return nil
+}
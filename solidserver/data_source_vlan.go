@@ -0,0 +1,128 @@
+package solidserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+func dataSourcevlan() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcevlanRead,
+
+		Schema: map[string]*schema.Schema{
+			"vlan_domain": {
+				Type:        schema.TypeString,
+				Description: "The name of the vlan domain.",
+				Required:    true,
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Description: "The vlan ID.",
+				Required:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the vlan.",
+				Computed:    true,
+			},
+			"class": {
+				Type:        schema.TypeString,
+				Description: "The class associated to the vlan.",
+				Computed:    true,
+			},
+			"class_parameters": {
+				Type:        schema.TypeMap,
+				Description: "The class parameters associated to the vlan.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Description: "The prefixes of the IP subnets associated to the vlan.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"subnets6": {
+				Type:        schema.TypeList,
+				Description: "The prefixes of the IPv6 subnets associated to the vlan.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcevlanRead(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+	d.SetId("")
+
+	// Building parameters
+	parameters := url.Values{}
+	whereClause := "vlmdomain_name='" + strings.ToLower(d.Get("vlan_domain").(string)) + "'" +
+		" AND vlmvlan_vlan_id='" + strconv.Itoa(d.Get("vlan_id").(int)) + "'"
+
+	parameters.Add("WHERE", whereClause)
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/vlmvlan_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			d.SetId(buf[0]["vlmvlan_id"].(string))
+
+			d.Set("name", buf[0]["vlmvlan_name"].(string))
+
+			if s.Version < 730 {
+				log.Printf("[INFO] SOLIDServer - Ignoring class_parameters for vlan: %s as not supported by your SOLIDserver version\n", d.Get("name").(string))
+			} else {
+				d.Set("class", buf[0]["vlmvlan_class_name"].(string))
+
+				// Setting local class_parameters
+				retrievedClassParameters, _ := url.ParseQuery(buf[0]["vlmvlan_class_parameters"].(string))
+				computedClassParameters := map[string]string{}
+
+				for ck := range retrievedClassParameters {
+					computedClassParameters[ck] = retrievedClassParameters[ck][0]
+				}
+
+				d.Set("class_parameters", computedClassParameters)
+			}
+
+			// Retrieving the associated subnets
+			vlansubnetsset(d, buf[0], meta)
+
+			return nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				// Log the error
+				log.Printf("[DEBUG] SOLIDServer - Unable to read information from vlan: %d (%s)\n", d.Get("vlan_id").(int), errMsg)
+			}
+		} else {
+			// Log the error
+			log.Printf("[DEBUG] SOLIDServer - Unable to read information from vlan: %d\n", d.Get("vlan_id").(int))
+		}
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find vlan: %d in vlan domain: %s\n", d.Get("vlan_id").(int), d.Get("vlan_domain").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
@@ -53,24 +53,33 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"solidserver_ip_space":         dataSourceipspace(),
-			"solidserver_ip_subnet":        dataSourceipsubnet(),
-			"solidserver_ip_subnet_query":  dataSourceipsubnetquery(),
-			"solidserver_ip6_subnet":       dataSourceip6subnet(),
-			"solidserver_ip6_subnet_query": dataSourceip6subnetquery(),
-			"solidserver_ip_pool":          dataSourceippool(),
-			"solidserver_ip6_pool":         dataSourceip6pool(),
-			"solidserver_ip_address":       dataSourceipaddress(),
-			"solidserver_ip6_address":      dataSourceip6address(),
-			"solidserver_ip_ptr":           dataSourceipptr(),
-			"solidserver_ip6_ptr":          dataSourceip6ptr(),
-			"solidserver_dns_smart":        dataSourcednssmart(),
-			"solidserver_dns_server":       dataSourcednsserver(),
-			"solidserver_dns_view":         dataSourcednsview(),
-			"solidserver_dns_zone":         dataSourcednszone(),
-			"solidserver_usergroup":        dataSourceusergroup(),
-			"solidserver_cdb":              dataSourcecdb(),
-			"solidserver_cdb_data":         dataSourcecdbdata(),
+			"solidserver_ip_space":           dataSourceipspace(),
+			"solidserver_ip_subnet":          dataSourceipsubnet(),
+			"solidserver_ip_subnet_query":    dataSourceipsubnetquery(),
+			"solidserver_ip6_subnet":         dataSourceip6subnet(),
+			"solidserver_ip6_subnet_query":   dataSourceip6subnetquery(),
+			"solidserver_ip_pool":            dataSourceippool(),
+			"solidserver_ip6_pool":           dataSourceip6pool(),
+			"solidserver_ip_address":         dataSourceipaddress(),
+			"solidserver_ip6_address":        dataSourceip6address(),
+			"solidserver_ip_addresses":       dataSourceipaddresses(),
+			"solidserver_ip6_addresses":      dataSourceip6addresses(),
+			"solidserver_ip_free_addresses":  dataSourceipfreeaddresses(),
+			"solidserver_ip6_free_addresses": dataSourceip6freeaddresses(),
+			"solidserver_ip_free_subnets":    dataSourceipfreesubnets(),
+			"solidserver_ip6_free_subnets":   dataSourceip6freesubnets(),
+			"solidserver_ip_utilization":     dataSourceiputilization(),
+			"solidserver_ip6_utilization":    dataSourceip6utilization(),
+			"solidserver_ip_ptr":             dataSourceipptr(),
+			"solidserver_ip6_ptr":            dataSourceip6ptr(),
+			"solidserver_vlan":               dataSourcevlan(),
+			"solidserver_dns_smart":          dataSourcednssmart(),
+			"solidserver_dns_server":         dataSourcednsserver(),
+			"solidserver_dns_view":           dataSourcednsview(),
+			"solidserver_dns_zone":           dataSourcednszone(),
+			"solidserver_usergroup":          dataSourceusergroup(),
+			"solidserver_cdb":                dataSourcecdb(),
+			"solidserver_cdb_data":           dataSourcecdbdata(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -86,6 +95,7 @@ func Provider() terraform.ResourceProvider {
 			"solidserver_ip_mac":           resourceipmac(),
 			"solidserver_ip6_mac":          resourceip6mac(),
 			"solidserver_device":           resourcedevice(),
+			"solidserver_host":             resourcehost(),
 			"solidserver_vlan_domain":      resourcevlandomain(),
 			"solidserver_vlan":             resourcevlan(),
 			"solidserver_dns_smart":        resourcednssmart(),
@@ -94,6 +104,12 @@ func Provider() terraform.ResourceProvider {
 			"solidserver_dns_zone":         resourcednszone(),
 			"solidserver_dns_forward_zone": resourcednsforwardzone(),
 			"solidserver_dns_rr":           resourcednsrr(),
+			"solidserver_dns_rrset":        resourcednsrrset(),
+			"solidserver_dns_rpz_zone":     resourcednsrpzzone(),
+			"solidserver_dns_rpz_rule":     resourcednsrpzrule(),
+			"solidserver_dns_tsig_key":     resourcednstsigkey(),
+			"solidserver_dns_acl":          resourcednsacl(),
+			"solidserver_dns_reverse_zone": resourcednsreversezone(),
 			"solidserver_app_application":  resourceapplication(),
 			"solidserver_app_pool":         resourceapplicationpool(),
 			"solidserver_app_node":         resourceapplicationnode(),
@@ -336,6 +336,18 @@ func resourceapplicationRead(d *schema.ResourceData, meta interface{}) error {
 func resourceapplicationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (name/fqdn) of the application if required
+	oid, oidErr := importidresolve(d.Id(), "name/fqdn", "application", func(keys []string) (string, error) {
+		return objectidbywhere("app_application_list", "appapplication_id", "appapplication_name='"+keys[0]+"' AND appapplication_fqdn='"+keys[1]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("appapplication_id", d.Id())
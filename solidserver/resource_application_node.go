@@ -496,6 +496,18 @@ func resourceapplicationnodeRead(d *schema.ResourceData, meta interface{}) error
 func resourceapplicationnodeImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (application/fqdn/pool/name) of the application node if required
+	oid, oidErr := importidresolve(d.Id(), "application/fqdn/pool/name", "application node", func(keys []string) (string, error) {
+		return objectidbywhere("app_node_list", "appnode_id", "appapplication_name='"+keys[0]+"' AND appapplication_fqdn='"+keys[1]+"' AND apppool_name='"+keys[2]+"' AND appnode_name='"+keys[3]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("appnode_id", d.Id())
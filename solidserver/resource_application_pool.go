@@ -351,6 +351,18 @@ func resourceapplicationpoolRead(d *schema.ResourceData, meta interface{}) error
 func resourceapplicationpoolImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (application/fqdn/name) of the application pool if required
+	oid, oidErr := importidresolve(d.Id(), "application/fqdn/name", "application pool", func(keys []string) (string, error) {
+		return objectidbywhere("app_pool_list", "apppool_id", "appapplication_name='"+keys[0]+"' AND appapplication_fqdn='"+keys[1]+"' AND apppool_name='"+keys[2]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("apppool_id", d.Id())
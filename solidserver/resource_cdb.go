@@ -328,6 +328,18 @@ func resourcecdbRead(d *schema.ResourceData, meta interface{}) error {
 func resourcecdbImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (name) of the Custom DB if required
+	oid, oidErr := importidresolve(d.Id(), "name", "Custom DB", func(keys []string) (string, error) {
+		return cdbnameidbyname(keys[0], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("custom_db_name_id", d.Id())
@@ -338,6 +338,18 @@ func resourcecdbdataRead(d *schema.ResourceData, meta interface{}) error {
 func resourcecdbdataImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (custom_db/value1) of the Custom DB data if required
+	oid, oidErr := importidresolve(d.Id(), "custom_db/value1", "Custom DB data", func(keys []string) (string, error) {
+		return objectidbywhere("custom_db_data_list", "custom_db_data_id", "name='"+keys[0]+"' AND value1='"+keys[1]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("custom_db_data_id", d.Id())
@@ -277,6 +277,18 @@ func resourcedeviceRead(d *schema.ResourceData, meta interface{}) error {
 func resourcedeviceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (name) of the device if required
+	oid, oidErr := importidresolve(d.Id(), "name", "device", func(keys []string) (string, error) {
+		return hostdevidbyname(keys[0], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("hostdev_id", d.Id())
@@ -0,0 +1,332 @@
+package solidserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+func resourcednsacl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcednsaclCreate,
+		Read:   resourcednsaclRead,
+		Update: resourcednsaclUpdate,
+		Delete: resourcednsaclDelete,
+		Exists: resourcednsaclExists,
+		Importer: &schema.ResourceImporter{
+			State: resourcednsaclImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dnsserver": {
+				Type:        schema.TypeString,
+				Description: "The managed SMART DNS server name, or DNS server name hosting the ACL.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the ACL, used to reference it from the ACL attributes of the DNS servers, views and zones.",
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z][A-Za-z0-9._\-]*$`), "Unsupported ACL name."),
+					validation.StringNotInSlice([]string{"any", "none", "localhost", "localnets"}, true),
+				),
+				Required: true,
+				ForceNew: true,
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs matched by the ACL. Use '!' to negate an entry.",
+				Required:    true,
+				ForceNew:    false,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourcednsaclvalidateentry,
+				},
+			},
+		},
+	}
+}
+
+func resourcednsaclvalidateentry(v interface{}, _ string) ([]string, []error) {
+	if match, _ := dnsaclmatch(v.(string)); match == false {
+		return nil, []error{fmt.Errorf("Only network prefixes, TSIG keys and named ACLs are supported.")}
+	}
+
+	return nil, nil
+}
+
+// Build the value of the ACL from its entries (ex: '10.0.0.0/8;!10.66.0.0/16;key xfr-key;')
+func resourcednsaclentries(d *schema.ResourceData) string {
+	entries := ""
+
+	for _, entry := range toStringArray(d.Get("entries").([]interface{})) {
+		entries += entry + ";"
+	}
+
+	return entries
+}
+
+func resourcednsaclExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnsacl_id", d.Id())
+
+	log.Printf("[DEBUG] Checking existence of DNS ACL (oid): %s\n", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_acl_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			return true, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				log.Printf("[DEBUG] SOLIDServer - Unable to find DNS ACL (oid): %s (%s)\n", d.Id(), errMsg)
+			}
+		} else {
+			log.Printf("[DEBUG] SOLIDServer - Unable to find DNS ACL (oid): %s\n", d.Id())
+		}
+
+		// Unset local ID
+		d.SetId("")
+	}
+
+	// Reporting a failure
+	return false, err
+}
+
+func resourcednsaclCreate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("add_flag", "new_only")
+	parameters.Add("dns_name", d.Get("dnsserver").(string))
+	parameters.Add("dnsacl_name", d.Get("name").(string))
+	parameters.Add("dnsacl_value", resourcednsaclentries(d))
+
+	// Sending the creation request
+	resp, body, err := s.Request("post", "rest/dns_acl_add", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Created DNS ACL (oid): %s\n", oid)
+				d.SetId(oid)
+				return nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to create DNS ACL: %s (%s)", d.Get("name").(string), errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to create DNS ACL: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednsaclUpdate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnsacl_id", d.Id())
+	parameters.Add("add_flag", "edit_only")
+	parameters.Add("dnsacl_value", resourcednsaclentries(d))
+
+	// Sending the update request
+	resp, body, err := s.Request("put", "rest/dns_acl_add", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Updated DNS ACL (oid): %s\n", oid)
+				d.SetId(oid)
+				return nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to update DNS ACL: %s (%s)", d.Get("name").(string), errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to update DNS ACL: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednsaclDelete(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnsacl_id", d.Id())
+
+	// Sending the deletion request
+	resp, body, err := s.Request("delete", "rest/dns_acl_delete", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			// Reporting a failure
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return fmt.Errorf("SOLIDServer - Unable to delete DNS ACL: %s (%s)", d.Get("name").(string), errMsg)
+				}
+			}
+
+			return fmt.Errorf("SOLIDServer - Unable to delete DNS ACL: %s", d.Get("name").(string))
+		}
+
+		// Log deletion
+		log.Printf("[DEBUG] SOLIDServer - Deleted DNS ACL (oid): %s\n", d.Id())
+
+		// Unset local ID
+		d.SetId("")
+
+		// Reporting a success
+		return nil
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednsaclRead(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnsacl_id", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_acl_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			d.Set("dnsserver", buf[0]["dns_name"].(string))
+			d.Set("name", buf[0]["dnsacl_name"].(string))
+
+			if buf[0]["dnsacl_value"].(string) != "" {
+				d.Set("entries", toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsacl_value"].(string), ";"), ";")))
+			} else {
+				d.Set("entries", []interface{}{})
+			}
+
+			return nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				// Log the error
+				log.Printf("[DEBUG] SOLIDServer - Unable to find DNS ACL: %s (%s)\n", d.Get("name"), errMsg)
+			}
+		} else {
+			// Log the error
+			log.Printf("[DEBUG] SOLIDServer - Unable to find DNS ACL (oid): %s\n", d.Id())
+		}
+
+		// Do not unset the local ID to avoid inconsistency
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find DNS ACL: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednsaclImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := meta.(*SOLIDserver)
+
+	// Resolving the natural key (dnsserver/name) of the DNS ACL if required
+	oid, oidErr := importidresolve(d.Id(), "dnsserver/name", "DNS ACL", func(keys []string) (string, error) {
+		return objectidbywhere("dns_acl_list", "dnsacl_id", "dns_name='"+keys[0]+"' AND dnsacl_name='"+keys[1]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnsacl_id", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_acl_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			d.Set("dnsserver", buf[0]["dns_name"].(string))
+			d.Set("name", buf[0]["dnsacl_name"].(string))
+
+			if buf[0]["dnsacl_value"].(string) != "" {
+				d.Set("entries", toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsacl_value"].(string), ";"), ";")))
+			}
+
+			return []*schema.ResourceData{d}, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				log.Printf("[DEBUG] SOLIDServer - Unable to import DNS ACL (oid): %s (%s)\n", d.Id(), errMsg)
+			}
+		} else {
+			log.Printf("[DEBUG] SOLIDServer - Unable to find and import DNS ACL (oid): %s\n", d.Id())
+		}
+
+		// Reporting a failure
+		return nil, fmt.Errorf("SOLIDServer - Unable to find and import DNS ACL (oid): %s\n", d.Id())
+	}
+
+	// Reporting a failure
+	return nil, err
+}
@@ -0,0 +1,70 @@
+//go:build all || dns_acl
+// +build all dns_acl
+
+// to test only these features: -tags dns_acl -run="dnsacl_XX"
+
+package solidserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/satori/go.uuid"
+)
+
+// create named ACL
+// + update its entries with a TSIG key and a negated prefix
+func TestAccdnsacl_01(t *testing.T) {
+	aclname := fmt.Sprintf("acl-01-%s", uuid.NewV4())
+	keyname := fmt.Sprintf("01-key-%s", uuid.NewV4())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Config_TestAccdnsacl_01(aclname, keyname, `"10.0.0.0/8", "172.16.0.0/12"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("solidserver_dns_acl.acl", "id"),
+					resource.TestCheckResourceAttr("solidserver_dns_acl.acl", "name", aclname),
+					resource.TestCheckResourceAttr("solidserver_dns_acl.acl", "entries.#", "2"),
+				),
+			},
+			{
+				Config: Config_TestAccdnsacl_01(aclname, keyname, `"10.0.0.0/8", "!10.66.0.0/16", "key ${solidserver_dns_tsig_key.key.name}"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("solidserver_dns_acl.acl", "entries.#", "3"),
+					resource.TestCheckResourceAttr("solidserver_dns_acl.acl", "entries.1", "!10.66.0.0/16"),
+					resource.TestCheckResourceAttr("solidserver_dns_acl.acl", "entries.2", "key "+keyname),
+				),
+			},
+			{
+				ResourceName:      "solidserver_dns_acl.acl",
+				ImportState:       true,
+				ImportStateId:     "ns.local/" + aclname,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Config_TestAccdnsacl_01(aclname string, keyname string, entries string) string {
+	return fmt.Sprintf(`
+    resource "solidserver_dns_tsig_key" "key" {
+      dnsserver = "ns.local"
+      name      = "%s"
+    }
+
+    resource "solidserver_dns_acl" "acl" {
+      dnsserver = "ns.local"
+      name      = "%s"
+      entries   = [%s]
+    }
+`, keyname,
+		aclname,
+		entries)
+}
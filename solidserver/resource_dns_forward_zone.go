@@ -343,6 +343,23 @@ func resourcednsforwardzoneRead(d *schema.ResourceData, meta interface{}) error
 func resourcednsforwardzoneImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (dnsserver/dnsview/name) of the DNS forward zone if required
+	oid, oidErr := importidresolve(d.Id(), "dnsserver/dnsview/name", "DNS forward zone", func(keys []string) (string, error) {
+		viewName := keys[1]
+		if viewName == "" {
+			viewName = "#"
+		}
+
+		return objectidbywhere("dns_zone_list", "dnszone_id", "dns_name='"+keys[0]+"' AND dnsview_name='"+viewName+"' AND dnszone_name='"+strings.ToLower(keys[2])+"' AND dnszone_type='forward'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("dnszone_id", d.Id())
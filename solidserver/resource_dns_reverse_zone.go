@@ -0,0 +1,326 @@
+package solidserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"log"
+	"net/url"
+	"strings"
+)
+
+func resourcednsreversezone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcednsreversezoneCreate,
+		Read:   resourcednsreversezoneRead,
+		Delete: resourcednsreversezoneDelete,
+		Exists: resourcednsreversezoneExists,
+		Importer: &schema.ResourceImporter{
+			State: resourcednsreversezoneImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dnsserver": {
+				Type:        schema.TypeString,
+				Description: "The managed SMART DNS server name, or DNS server name hosting the reverse zone(s).",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dnsview": {
+				Type:        schema.TypeString,
+				Description: "The View name of the reverse zone(s).",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "#",
+			},
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of the IP space the reverse zone(s) are linked to, PTR records of the space's addresses are then managed automatically.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Description:  "The IP or IPv6 prefix (CIDR) covered by the reverse zone(s).",
+				ValidateFunc: validation.IsCIDR,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"classless_delegation": {
+				Type:        schema.TypeBool,
+				Description: "Create the RFC 2317 CNAMEs in the parent /24 reverse zone of an IPv4 prefix longer than /24 (Default: true).",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Description: "The reverse zones computed from the prefix.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the reverse zone.",
+							Computed:    true,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the reverse zone.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Retrieve a reverse zone from its name
+// Return nil if it doesn't exist
+func resourcednsreversezonelookup(d *schema.ResourceData, zoneName string, meta interface{}) (map[string]interface{}, error) {
+	s := meta.(*SOLIDserver)
+
+	viewName := d.Get("dnsview").(string)
+	if viewName == "" {
+		viewName = "#"
+	}
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "dns_name='"+d.Get("dnsserver").(string)+"' AND dnsview_name='"+strings.ToLower(viewName)+"' AND dnszone_name='"+zoneName+"'")
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_zone_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if _, oidExist := buf[0]["dnszone_id"].(string); oidExist {
+				return buf[0], nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	// Reporting a failure
+	return nil, err
+}
+
+// Retrieve all the reverse zones of the prefix and update the local state from them
+// Return the number of existing zones out of the expected ones
+func resourcednsreversezoneset(d *schema.ResourceData, meta interface{}) (int, int, error) {
+	zoneNames, err := dnsreversezonenames(d.Get("prefix").(string))
+
+	if err != nil {
+		// Reporting a failure
+		return 0, 0, err
+	}
+
+	zones := []interface{}{}
+
+	for _, zoneName := range zoneNames {
+		zone, zoneErr := resourcednsreversezonelookup(d, zoneName, meta)
+
+		if zoneErr != nil {
+			// Reporting a failure
+			return 0, len(zoneNames), zoneErr
+		}
+
+		if zone == nil {
+			log.Printf("[DEBUG] SOLIDServer - Unable to find reverse zone: %s\n", zoneName)
+			continue
+		}
+
+		if len(zones) == 0 {
+			d.SetId(zone["dnszone_id"].(string))
+			d.Set("dnsserver", zone["dns_name"].(string))
+
+			if siteName, siteNameExist := zone["dnszone_site_name"].(string); siteNameExist && siteName != "#" {
+				d.Set("space", siteName)
+			}
+		}
+
+		zones = append(zones, map[string]interface{}{
+			"name": zone["dnszone_name"].(string),
+			"id":   zone["dnszone_id"].(string),
+		})
+	}
+
+	d.Set("zones", zones)
+
+	return len(zones), len(zoneNames), nil
+}
+
+func resourcednsreversezoneExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	log.Printf("[DEBUG] Checking existence of reverse zone(s) of prefix: %s\n", d.Get("prefix").(string))
+
+	found, _, err := resourcednsreversezoneset(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return false, err
+	}
+
+	if found == 0 {
+		// Log the error
+		log.Printf("[DEBUG] SOLIDServer - Unable to find reverse zone(s) of prefix: %s\n", d.Get("prefix").(string))
+
+		// Unset local ID
+		d.SetId("")
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func resourcednsreversezoneCreate(d *schema.ResourceData, meta interface{}) error {
+	zoneNames, err := dnsreversezonenames(d.Get("prefix").(string))
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteErr != nil {
+		// Reporting a failure
+		return siteErr
+	}
+
+	zones := []interface{}{}
+
+	for _, zoneName := range zoneNames {
+		zoneID, zoneErr := dnszoneadd(d.Get("dnsserver").(string), d.Get("dnsview").(string), zoneName, siteID, meta)
+
+		if zoneErr != nil {
+			// Rolling back the zones already created
+			for _, zone := range zones {
+				dnszonedeletebyid(zone.(map[string]interface{})["id"].(string), meta)
+			}
+
+			// Reporting a failure
+			return zoneErr
+		}
+
+		zones = append(zones, map[string]interface{}{
+			"name": zoneName,
+			"id":   zoneID,
+		})
+	}
+
+	d.SetId(zones[0].(map[string]interface{})["id"].(string))
+	d.Set("zones", zones)
+
+	log.Printf("[DEBUG] SOLIDServer - Created reverse zone(s) of prefix: %s\n", d.Get("prefix").(string))
+
+	// Delegating the addresses of a classless zone from its parent zone
+	if d.Get("classless_delegation").(bool) {
+		parentZone, cnames := dnsclasslesscnames(d.Get("prefix").(string))
+
+		for rrName, value := range cnames {
+			if _, rrErr := dnsrrset("", d.Get("dnsserver").(string), resourcednsreversezoneview(d), parentZone, rrName, "CNAME", value, 3600, meta); rrErr != nil {
+				// Reporting a failure
+				return fmt.Errorf("SOLIDServer - Unable to delegate prefix: %s from reverse zone: %s (%s)", d.Get("prefix").(string), parentZone, rrErr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Return the View name of the reverse zone(s) as expected by the RR services
+func resourcednsreversezoneview(d *schema.ResourceData) string {
+	if d.Get("dnsview").(string) == "#" {
+		return ""
+	}
+
+	return d.Get("dnsview").(string)
+}
+
+func resourcednsreversezoneDelete(d *schema.ResourceData, meta interface{}) error {
+	// Removing the delegation of the addresses of a classless zone
+	if d.Get("classless_delegation").(bool) {
+		_, cnames := dnsclasslesscnames(d.Get("prefix").(string))
+
+		for rrName, value := range cnames {
+			if err := dnsrrdeletebyinfo(d.Get("dnsserver").(string), resourcednsreversezoneview(d), rrName, "CNAME", value, meta); err != nil {
+				// Reporting a failure
+				return err
+			}
+		}
+	}
+
+	for _, zone := range d.Get("zones").([]interface{}) {
+		if err := dnszonedeletebyid(zone.(map[string]interface{})["id"].(string), meta); err != nil {
+			// Reporting a failure
+			return err
+		}
+	}
+
+	// Log deletion
+	log.Printf("[DEBUG] SOLIDServer - Deleted reverse zone(s) of prefix: %s\n", d.Get("prefix").(string))
+
+	// Unset local ID
+	d.SetId("")
+
+	// Reporting a success
+	return nil
+}
+
+func resourcednsreversezoneRead(d *schema.ResourceData, meta interface{}) error {
+	found, expected, err := resourcednsreversezoneset(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	if found != expected {
+		// Log the error
+		log.Printf("[DEBUG] SOLIDServer - Unable to find %d out of %d reverse zone(s) of prefix: %s\n", expected-found, expected, d.Get("prefix").(string))
+
+		// Do not unset the local ID to avoid inconsistency
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find reverse zone(s) of prefix: %s\n", d.Get("prefix").(string))
+	}
+
+	return nil
+}
+
+func resourcednsreversezoneImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keys := strings.SplitN(d.Id(), "/", 3)
+
+	// The prefix comes last as it holds a '/'
+	if len(keys) != 3 {
+		return nil, fmt.Errorf("SOLIDServer - Unable to import reverse zone(s): %s, expecting a key like dnsserver/dnsview/prefix\n", d.Id())
+	}
+
+	d.Set("dnsserver", keys[0])
+	d.Set("dnsview", keys[1])
+	d.Set("prefix", keys[2])
+	d.Set("classless_delegation", true)
+
+	found, expected, err := resourcednsreversezoneset(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return nil, err
+	}
+
+	if found == 0 || found != expected {
+		// Reporting a failure
+		return nil, fmt.Errorf("SOLIDServer - Unable to find and import reverse zone(s): %s\n", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,84 @@
+//go:build all || dns_reverse_zone
+// +build all dns_reverse_zone
+
+// to test only these features: -tags dns_reverse_zone -run="dnsreversezone_XX"
+
+package solidserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// create the reverse zones of a /23 split into two /24 zones
+func TestAccdnsreversezone_01(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Config_TestAccdnsreversezone_01("10.250.0.0/23"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("solidserver_dns_reverse_zone.reverse", "id"),
+					resource.TestCheckResourceAttr("solidserver_dns_reverse_zone.reverse", "zones.#", "2"),
+					resource.TestCheckResourceAttr("solidserver_dns_reverse_zone.reverse", "zones.0.name", "0.250.10.in-addr.arpa"),
+					resource.TestCheckResourceAttr("solidserver_dns_reverse_zone.reverse", "zones.1.name", "1.250.10.in-addr.arpa"),
+				),
+			},
+			{
+				ResourceName:      "solidserver_dns_reverse_zone.reverse",
+				ImportState:       true,
+				ImportStateId:     "ns.local/#/10.250.0.0/23",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// create a RFC 2317 classless reverse zone delegated from its parent zone
+func TestAccdnsreversezone_02(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Config_TestAccdnsreversezone_02("10.251.0.64/26"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("solidserver_dns_reverse_zone.parent", "zones.0.name", "0.251.10.in-addr.arpa"),
+					resource.TestCheckResourceAttr("solidserver_dns_reverse_zone.reverse", "zones.#", "1"),
+					resource.TestCheckResourceAttr("solidserver_dns_reverse_zone.reverse", "zones.0.name", "64/26.0.251.10.in-addr.arpa"),
+				),
+			},
+		},
+	})
+}
+
+func Config_TestAccdnsreversezone_01(prefix string) string {
+	return fmt.Sprintf(`
+    resource "solidserver_dns_reverse_zone" "reverse" {
+      dnsserver = "ns.local"
+      prefix    = "%s"
+    }
+`, prefix)
+}
+
+func Config_TestAccdnsreversezone_02(prefix string) string {
+	return fmt.Sprintf(`
+    resource "solidserver_dns_reverse_zone" "parent" {
+      dnsserver = "ns.local"
+      prefix    = "10.251.0.0/24"
+    }
+
+    resource "solidserver_dns_reverse_zone" "reverse" {
+      dnsserver  = "ns.local"
+      prefix     = "%s"
+      depends_on = ["solidserver_dns_reverse_zone.parent"]
+    }
+`, prefix)
+}
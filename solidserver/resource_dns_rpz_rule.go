@@ -0,0 +1,303 @@
+package solidserver
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+)
+
+func resourcednsrpzrule() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourcednsrpzruleCreate,
+		Read:          resourcednsrpzruleRead,
+		Update:        resourcednsrpzruleUpdate,
+		Delete:        resourcednsrpzruleDelete,
+		Exists:        resourcednsrpzruleExists,
+		CustomizeDiff: resourcednsrpzruleCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourcednsrpzruleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dnsserver": {
+				Type:        schema.TypeString,
+				Description: "The managed SMART DNS server name, or DNS server name hosting the RPZ zone of the rule.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dnsview": {
+				Type:        schema.TypeString,
+				Description: "The View name of the RPZ zone of the rule.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
+			"dnszone": {
+				Type:        schema.TypeString,
+				Description: "The name of the RPZ zone hosting the rule.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"trigger": {
+				Type:             schema.TypeString,
+				Description:      "The trigger of the rule (Supported: QNAME, IP, NSDNAME, NSIP; Default: QNAME).",
+				ValidateFunc:     validation.StringInSlice([]string{"QNAME", "IP", "NSDNAME", "NSIP"}, true),
+				DiffSuppressFunc: resourcediffsuppresscase,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "QNAME",
+			},
+			"match": {
+				Type:             schema.TypeString,
+				Description:      "The domain name (QNAME, NSDNAME) or the IP/IPv6 prefix (IP, NSIP) matched by the rule.",
+				DiffSuppressFunc: resourcediffsuppressfqdn,
+				Required:         true,
+				ForceNew:         true,
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Description:      "The policy action of the rule (Supported: NXDOMAIN, NODATA, PASSTHRU, DROP, CNAME; Default: NXDOMAIN).",
+				ValidateFunc:     validation.StringInSlice([]string{"NXDOMAIN", "NODATA", "PASSTHRU", "DROP", "CNAME"}, true),
+				DiffSuppressFunc: resourcediffsuppresscase,
+				Optional:         true,
+				Default:          "NXDOMAIN",
+			},
+			"redirect": {
+				Type:        schema.TypeString,
+				Description: "The domain name the matching queries are redirected to (CNAME action only).",
+				Optional:    true,
+				Default:     "",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "The DNS Time To Live of the rule.",
+				Optional:    true,
+				Default:     3600,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the RR implementing the rule.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Check that the value to match is consistent with the trigger and that only the CNAME action has a redirection
+func resourcednsrpzruleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	trigger := strings.ToUpper(d.Get("trigger").(string))
+	match := d.Get("match").(string)
+
+	if d.NewValueKnown("trigger") && d.NewValueKnown("match") && (trigger == "IP" || trigger == "NSIP") {
+		ip, prefix, err := net.ParseCIDR(match)
+
+		if err != nil {
+			return fmt.Errorf("SOLIDServer - The %s trigger of a RPZ rule requires an IP or IPv6 prefix (ex: 10.0.0.0/8), got: %s", trigger, match)
+		}
+
+		if !ip.Equal(prefix.IP) {
+			return fmt.Errorf("SOLIDServer - The prefix matched by a RPZ rule must be a network address: %s (expecting: %s)", match, prefix.String())
+		}
+	}
+
+	if d.NewValueKnown("action") && d.NewValueKnown("redirect") {
+		if strings.ToUpper(d.Get("action").(string)) == "CNAME" && d.Get("redirect").(string) == "" {
+			return fmt.Errorf("SOLIDServer - The CNAME action of a RPZ rule requires a redirect target")
+		}
+
+		if strings.ToUpper(d.Get("action").(string)) != "CNAME" && d.Get("redirect").(string) != "" {
+			return fmt.Errorf("SOLIDServer - A redirect target can only be set on a RPZ rule with the CNAME action")
+		}
+	}
+
+	return nil
+}
+
+// Return the value of the CNAME implementing the action of the rule
+func resourcednsrpzrulevalue(d *schema.ResourceData) string {
+	if value, valueExist := dnsrpzactionvalues[strings.ToUpper(d.Get("action").(string))]; valueExist {
+		return value
+	}
+
+	return d.Get("redirect").(string)
+}
+
+// Retrieve the RR implementing the rule
+// Return nil if it doesn't exist
+func resourcednsrpzrulerr(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	rrName, err := dnsrpzrulename(d.Get("trigger").(string), d.Get("match").(string), d.Get("dnszone").(string))
+
+	if err != nil {
+		// Reporting a failure
+		return nil, err
+	}
+
+	rrs, err := dnsrrlist(d.Get("dnsserver").(string), d.Get("dnsview").(string), d.Get("dnszone").(string), rrName, "CNAME", meta)
+
+	if err != nil || len(rrs) == 0 {
+		return nil, err
+	}
+
+	return rrs[0], nil
+}
+
+// Update the local state of the rule from the RR implementing it
+func resourcednsrpzruleset(d *schema.ResourceData, rr map[string]interface{}) {
+	trigger, match := dnsrpzruletrigger(rr["rr_full_name"].(string), d.Get("dnszone").(string))
+	action, redirect := dnsrpzruleaction(dnsrrvaluefrominfo(rr))
+	ttl, _ := strconv.Atoi(rr["ttl"].(string))
+
+	d.SetId(rr["rr_id"].(string))
+	d.Set("dnsserver", rr["dns_name"].(string))
+	d.Set("trigger", trigger)
+	d.Set("match", match)
+	d.Set("action", action)
+	d.Set("redirect", redirect)
+	d.Set("ttl", ttl)
+	d.Set("name", rr["rr_full_name"].(string))
+
+	if rr["dnsview_name"].(string) != "#" {
+		d.Set("dnsview", rr["dnsview_name"].(string))
+	}
+}
+
+func resourcednsrpzruleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	log.Printf("[DEBUG] Checking existence of RPZ rule (oid): %s\n", d.Id())
+
+	rr, err := resourcednsrpzrulerr(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return false, err
+	}
+
+	if rr == nil {
+		// Log the error
+		log.Printf("[DEBUG] SOLIDServer - Unable to find RPZ rule (oid): %s\n", d.Id())
+
+		// Unset local ID
+		d.SetId("")
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func resourcednsrpzruleCreate(d *schema.ResourceData, meta interface{}) error {
+	rrName, err := dnsrpzrulename(d.Get("trigger").(string), d.Get("match").(string), d.Get("dnszone").(string))
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	oid, err := dnsrrset("", d.Get("dnsserver").(string), d.Get("dnsview").(string), d.Get("dnszone").(string), rrName, "CNAME", resourcednsrpzrulevalue(d), d.Get("ttl").(int), meta)
+
+	if err != nil {
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to create RPZ rule: %s %s (%s)", strings.ToUpper(d.Get("trigger").(string)), d.Get("match").(string), err)
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Created RPZ rule (oid): %s\n", oid)
+	d.SetId(oid)
+	d.Set("name", rrName)
+
+	return nil
+}
+
+func resourcednsrpzruleUpdate(d *schema.ResourceData, meta interface{}) error {
+	rrName, err := dnsrpzrulename(d.Get("trigger").(string), d.Get("match").(string), d.Get("dnszone").(string))
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	oid, err := dnsrrset(d.Id(), d.Get("dnsserver").(string), d.Get("dnsview").(string), d.Get("dnszone").(string), rrName, "CNAME", resourcednsrpzrulevalue(d), d.Get("ttl").(int), meta)
+
+	if err != nil {
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to update RPZ rule: %s %s (%s)", strings.ToUpper(d.Get("trigger").(string)), d.Get("match").(string), err)
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Updated RPZ rule (oid): %s\n", oid)
+	d.SetId(oid)
+
+	return nil
+}
+
+func resourcednsrpzruleDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := dnsrrdeletebyid(d.Id(), d.Get("dnsview").(string), d.Get("name").(string), meta); err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	// Log deletion
+	log.Printf("[DEBUG] SOLIDServer - Deleted RPZ rule (oid): %s\n", d.Id())
+
+	// Unset local ID
+	d.SetId("")
+
+	// Reporting a success
+	return nil
+}
+
+func resourcednsrpzruleRead(d *schema.ResourceData, meta interface{}) error {
+	// Attempt to not rely on the ID that may change due to DNS behavior
+	rr, err := resourcednsrpzrulerr(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	if rr == nil {
+		// Log the error
+		log.Printf("[DEBUG] SOLIDServer - Unable to find RPZ rule (oid): %s\n", d.Id())
+
+		// Do not unset the local ID to avoid inconsistency
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find RPZ rule: %s %s\n", strings.ToUpper(d.Get("trigger").(string)), d.Get("match").(string))
+	}
+
+	resourcednsrpzruleset(d, rr)
+
+	return nil
+}
+
+func resourcednsrpzruleImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keys := strings.SplitN(d.Id(), "/", 5)
+
+	// The value to match comes last as IP prefixes hold a '/'
+	if len(keys) != 5 {
+		return nil, fmt.Errorf("SOLIDServer - Unable to import RPZ rule: %s, expecting a key like dnsserver/dnsview/dnszone/trigger/match\n", d.Id())
+	}
+
+	d.Set("dnsserver", keys[0])
+	d.Set("dnsview", keys[1])
+	d.Set("dnszone", keys[2])
+	d.Set("trigger", strings.ToUpper(keys[3]))
+	d.Set("match", keys[4])
+
+	rr, err := resourcednsrpzrulerr(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return nil, err
+	}
+
+	if rr == nil {
+		// Reporting a failure
+		return nil, fmt.Errorf("SOLIDServer - Unable to find and import RPZ rule: %s\n", d.Id())
+	}
+
+	resourcednsrpzruleset(d, rr)
+
+	return []*schema.ResourceData{d}, nil
+}
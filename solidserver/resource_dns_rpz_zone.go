@@ -0,0 +1,410 @@
+package solidserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"net/url"
+	"strings"
+)
+
+func resourcednsrpzzone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcednsrpzzoneCreate,
+		Read:   resourcednsrpzzoneRead,
+		Update: resourcednsrpzzoneUpdate,
+		Delete: resourcednsrpzzoneDelete,
+		Exists: resourcednsrpzzoneExists,
+		Importer: &schema.ResourceImporter{
+			State: resourcednsrpzzoneImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dnsserver": {
+				Type:        schema.TypeString,
+				Description: "The managed SMART DNS server name, or DNS server name hosting the RPZ zone.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dnsview": {
+				Type:        schema.TypeString,
+				Description: "The DNS view name hosting the RPZ zone.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "#",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the RPZ zone.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of a space associated to the RPZ zone.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"class": {
+				Type:        schema.TypeString,
+				Description: "The class associated to the RPZ zone.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"class_parameters": {
+				Type:        schema.TypeMap,
+				Description: "The class parameters associated to the RPZ zone.",
+				Optional:    true,
+				ForceNew:    false,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourcednsrpzzoneExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnszone_id", d.Id())
+
+	log.Printf("[DEBUG] Checking existence of DNS RPZ zone (oid): %s\n", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_zone_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			return true, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				log.Printf("[DEBUG] SOLIDServer - Unable to find DNS RPZ zone (oid): %s (%s)\n", d.Id(), errMsg)
+			}
+		} else {
+			log.Printf("[DEBUG] SOLIDServer - Unable to find DNS RPZ zone (oid): %s\n", d.Id())
+		}
+
+		// Unset local ID
+		d.SetId("")
+	}
+
+	// Reporting a failure
+	return false, err
+}
+
+func resourcednsrpzzoneCreate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Gather required ID(s) from provided information
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteErr != nil {
+		// Reporting a failure
+		return siteErr
+	}
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("add_flag", "new_only")
+	parameters.Add("dns_name", d.Get("dnsserver").(string))
+	if strings.Compare(d.Get("dnsview").(string), "#") != 0 {
+		parameters.Add("dnsview_name", strings.ToLower(d.Get("dnsview").(string)))
+	}
+	parameters.Add("dnszone_name", d.Get("name").(string))
+	parameters.Add("dnszone_type", "master")
+	parameters.Add("dnszone_is_rpz", "1")
+	parameters.Add("dnszone_site_id", siteID)
+	parameters.Add("dnszone_class_name", d.Get("class").(string))
+
+	// Building class_parameters
+	classParameters := urlfromclassparams(d.Get("class_parameters"))
+	parameters.Add("dnszone_class_parameters", classParameters.Encode())
+
+	// Sending the creation request
+	resp, body, err := s.Request("post", "rest/dns_zone_add", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Created DNS RPZ zone (oid): %s\n", oid)
+				d.SetId(oid)
+				return nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to create DNS RPZ zone: %s (%s)", d.Get("name").(string), errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to create DNS RPZ zone: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednsrpzzoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Gather required ID(s) from provided information
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteErr != nil {
+		// Reporting a failure
+		return siteErr
+	}
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnszone_id", d.Id())
+	parameters.Add("add_flag", "edit_only")
+	if strings.Compare(d.Get("dnsview").(string), "#") != 0 {
+		parameters.Add("dnsview_name", strings.ToLower(d.Get("dnsview").(string)))
+	}
+	parameters.Add("dnszone_site_id", siteID)
+	parameters.Add("dnszone_class_name", d.Get("class").(string))
+
+	// Building class_parameters
+	classParameters := urlfromclassparams(d.Get("class_parameters"))
+	parameters.Add("dnszone_class_parameters", classParameters.Encode())
+
+	// Sending the update request
+	resp, body, err := s.Request("put", "rest/dns_zone_add", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Updated DNS RPZ zone (oid): %s\n", oid)
+				d.SetId(oid)
+				return nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to update DNS RPZ zone: %s (%s)", d.Get("name").(string), errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to update DNS RPZ zone: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednsrpzzoneDelete(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnszone_id", d.Id())
+
+	if strings.Compare(d.Get("dnsview").(string), "#") != 0 {
+		parameters.Add("dnsview_name", strings.ToLower(d.Get("dnsview").(string)))
+	}
+
+	// Sending the deletion request
+	resp, body, err := s.Request("delete", "rest/dns_zone_delete", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			// Reporting a failure
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return fmt.Errorf("SOLIDServer - Unable to delete DNS RPZ zone: %s (%s)", d.Get("name").(string), errMsg)
+				}
+			}
+
+			return fmt.Errorf("SOLIDServer - Unable to delete DNS RPZ zone: %s", d.Get("name").(string))
+		}
+
+		// Log deletion
+		log.Printf("[DEBUG] SOLIDServer - Deleted DNS RPZ zone (oid): %s\n", d.Id())
+
+		// Unset local ID
+		d.SetId("")
+
+		// Reporting a success
+		return nil
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednsrpzzoneRead(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnszone_id", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_zone_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			d.Set("dnsserver", buf[0]["dns_name"].(string))
+			d.Set("dnsview", buf[0]["dnsview_name"].(string))
+			d.Set("name", buf[0]["dnszone_name"].(string))
+
+			if buf[0]["dnszone_site_name"].(string) != "#" {
+				d.Set("space", buf[0]["dnszone_site_name"].(string))
+			} else {
+				d.Set("space", "")
+			}
+
+			d.Set("class", buf[0]["dnszone_class_name"].(string))
+
+			// Updating local class_parameters
+			currentClassParameters := d.Get("class_parameters").(map[string]interface{})
+			retrievedClassParameters, _ := url.ParseQuery(buf[0]["dnszone_class_parameters"].(string))
+			computedClassParameters := map[string]string{}
+
+			for ck := range currentClassParameters {
+				if rv, rvExist := retrievedClassParameters[ck]; rvExist {
+					computedClassParameters[ck] = rv[0]
+				} else {
+					computedClassParameters[ck] = ""
+				}
+			}
+
+			d.Set("class_parameters", computedClassParameters)
+
+			return nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				// Log the error
+				log.Printf("[DEBUG] SOLIDServer - Unable to find DNS RPZ zone: %s (%s)\n", d.Get("name"), errMsg)
+			}
+		} else {
+			// Log the error
+			log.Printf("[DEBUG] SOLIDServer - Unable to find DNS RPZ zone (oid): %s\n", d.Id())
+		}
+
+		// Do not unset the local ID to avoid inconsistency
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find DNS RPZ zone: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednsrpzzoneImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := meta.(*SOLIDserver)
+
+	// Resolving the natural key (dnsserver/dnsview/name) of the DNS RPZ zone if required
+	oid, oidErr := importidresolve(d.Id(), "dnsserver/dnsview/name", "DNS RPZ zone", func(keys []string) (string, error) {
+		viewName := keys[1]
+		if viewName == "" {
+			viewName = "#"
+		}
+
+		return objectidbywhere("dns_zone_list", "dnszone_id", "dns_name='"+keys[0]+"' AND dnsview_name='"+viewName+"' AND dnszone_name='"+strings.ToLower(keys[2])+"' AND dnszone_is_rpz='1'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnszone_id", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_zone_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			// Only RPZ zones can be imported
+			if isRPZ, _ := buf[0]["dnszone_is_rpz"].(string); isRPZ != "1" {
+				return nil, fmt.Errorf("SOLIDServer - Unable to import DNS RPZ zone (oid): %s (Not a RPZ zone)\n", d.Id())
+			}
+
+			d.Set("dnsserver", buf[0]["dns_name"].(string))
+			d.Set("dnsview", buf[0]["dnsview_name"].(string))
+			d.Set("name", buf[0]["dnszone_name"].(string))
+
+			if buf[0]["dnszone_site_name"].(string) != "#" {
+				d.Set("space", buf[0]["dnszone_site_name"].(string))
+			} else {
+				d.Set("space", "")
+			}
+
+			d.Set("class", buf[0]["dnszone_class_name"].(string))
+
+			// Updating local class_parameters
+			currentClassParameters := d.Get("class_parameters").(map[string]interface{})
+			retrievedClassParameters, _ := url.ParseQuery(buf[0]["dnszone_class_parameters"].(string))
+			computedClassParameters := map[string]string{}
+
+			for ck := range currentClassParameters {
+				if rv, rvExist := retrievedClassParameters[ck]; rvExist {
+					computedClassParameters[ck] = rv[0]
+				} else {
+					computedClassParameters[ck] = ""
+				}
+			}
+
+			d.Set("class_parameters", computedClassParameters)
+
+			return []*schema.ResourceData{d}, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				log.Printf("[DEBUG] SOLIDServer - Unable to import DNS RPZ zone (oid): %s (%s)\n", d.Id(), errMsg)
+			}
+		} else {
+			log.Printf("[DEBUG] SOLIDServer - Unable to find and import DNS RPZ zone (oid): %s\n", d.Id())
+		}
+
+		// Reporting a failure
+		return nil, fmt.Errorf("SOLIDServer - Unable to find and import DNS RPZ zone (oid): %s\n", d.Id())
+	}
+
+	// Reporting a failure
+	return nil, err
+}
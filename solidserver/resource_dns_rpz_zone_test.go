@@ -0,0 +1,81 @@
+//go:build all || dns_rpz
+// +build all dns_rpz
+
+// to test only these features: -tags dns_rpz -run="dnsrpz_XX"
+
+package solidserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/satori/go.uuid"
+)
+
+// create RPZ zone
+// + QNAME rule
+// + IP rule redirecting to a walled garden
+func TestAccdnsrpz_01(t *testing.T) {
+	zonename := fmt.Sprintf("01-rpz-%s.local", uuid.NewV4())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Config_TestAccdnsrpz_01(zonename, "NXDOMAIN"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("solidserver_dns_rpz_zone.zone", "id"),
+					resource.TestCheckResourceAttr("solidserver_dns_rpz_zone.zone", "name", zonename),
+					resource.TestCheckResourceAttrSet("solidserver_dns_rpz_rule.qname", "id"),
+					resource.TestCheckResourceAttr("solidserver_dns_rpz_rule.qname", "name", "*.malware.example."+zonename),
+					resource.TestCheckResourceAttr("solidserver_dns_rpz_rule.qname", "action", "NXDOMAIN"),
+					resource.TestCheckResourceAttr("solidserver_dns_rpz_rule.ip", "name", "24.0.2.0.192.rpz-ip."+zonename),
+					resource.TestCheckResourceAttr("solidserver_dns_rpz_rule.ip", "redirect", "walled-garden.local"),
+				),
+			},
+			{
+				Config: Config_TestAccdnsrpz_01(zonename, "PASSTHRU"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("solidserver_dns_rpz_rule.qname", "action", "PASSTHRU"),
+				),
+			},
+			{
+				ResourceName:      "solidserver_dns_rpz_zone.zone",
+				ImportState:       true,
+				ImportStateId:     "ns.local//" + zonename,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Config_TestAccdnsrpz_01(zonename string, action string) string {
+	return fmt.Sprintf(`
+    resource "solidserver_dns_rpz_zone" "zone" {
+      dnsserver = "ns.local"
+      name      = "%s"
+    }
+
+    resource "solidserver_dns_rpz_rule" "qname" {
+      dnsserver = "ns.local"
+      dnszone   = "${solidserver_dns_rpz_zone.zone.name}"
+      match     = "*.malware.example"
+      action    = "%s"
+    }
+
+    resource "solidserver_dns_rpz_rule" "ip" {
+      dnsserver = "ns.local"
+      dnszone   = "${solidserver_dns_rpz_zone.zone.name}"
+      trigger   = "IP"
+      match     = "192.0.2.0/24"
+      action    = "CNAME"
+      redirect  = "walled-garden.local"
+    }
+`, zonename,
+		action)
+}
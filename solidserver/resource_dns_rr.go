@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"log"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -20,6 +22,7 @@ func resourcednsrr() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcednsrrImportState,
 		},
+		CustomizeDiff: resourcednsrrCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"dnsserver": {
@@ -50,18 +53,253 @@ func resourcednsrr() *schema.Resource {
 			},
 			"type": {
 				Type:         schema.TypeString,
-				Description:  "The type of the RR to create (Supported: A, AAAA, CNAME, TXT).",
+				Description:  "The type of the RR to create (Supported: A, AAAA, CNAME, DNAME, TXT, NS, PTR, MX, SRV, CAA, NAPTR, SSHFP, TLSA, DS).",
 				ValidateFunc: resourcednsrrvalidatetype,
 				Required:     true,
 				ForceNew:     true,
 			},
 			"value": {
 				Type:             schema.TypeString,
-				Description:      "The value od the RR to create.",
+				Description:      "The value od the RR to create (Required for A, AAAA, CNAME, DNAME, TXT, NS and PTR records).",
 				Computed:         false,
-				Required:         true,
-				ForceNew:         true,
+				Optional:         true,
+				ForceNew:         false,
 				DiffSuppressFunc: resourcediffsuppressIPv6Format,
+				Default:          "",
+			},
+			"mx": {
+				Type:        schema.TypeList,
+				Description: "The MX record data (Required for MX records).",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Description:  "The preference of the mail exchanger.",
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Required:     true,
+						},
+						"target": {
+							Type:         schema.TypeString,
+							Description:  "The FQDN of the mail exchanger.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Required:     true,
+						},
+					},
+				},
+			},
+			"srv": {
+				Type:        schema.TypeList,
+				Description: "The SRV record data (Required for SRV records).",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Description:  "The priority of the target host.",
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Required:     true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Description:  "The relative weight of the target host among those of the same priority.",
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Required:     true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Description:  "The port of the service on the target host.",
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Required:     true,
+						},
+						"target": {
+							Type:         schema.TypeString,
+							Description:  "The FQDN of the target host.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							Required:     true,
+						},
+					},
+				},
+			},
+			"caa": {
+				Type:        schema.TypeList,
+				Description: "The CAA record data (Required for CAA records).",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flags": {
+							Type:         schema.TypeInt,
+							Description:  "The flags of the CAA record (Supported: 0 or 128 for critical; Default: 0).",
+							ValidateFunc: validation.IntInSlice([]int{0, 128}),
+							Optional:     true,
+							Default:      0,
+						},
+						"tag": {
+							Type:         schema.TypeString,
+							Description:  "The property tag of the CAA record (Supported: issue, issuewild, iodef).",
+							ValidateFunc: validation.StringInSlice([]string{"issue", "issuewild", "iodef"}, false),
+							Required:     true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The property value of the CAA record (ex: a CA domain name or an iodef URL).",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"naptr": {
+				Type:        schema.TypeList,
+				Description: "The NAPTR record data (Required for NAPTR records).",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"order": {
+							Type:         schema.TypeInt,
+							Description:  "The order in which the NAPTR records must be processed.",
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Required:     true,
+						},
+						"preference": {
+							Type:         schema.TypeInt,
+							Description:  "The preference of the NAPTR records of the same order.",
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Required:     true,
+						},
+						"flags": {
+							Type:         schema.TypeString,
+							Description:  "The flags of the NAPTR record (ex: S, A, U or P).",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile("^[A-Za-z0-9]*$"), "Unsupported NAPTR flags."),
+							Optional:     true,
+							Default:      "",
+						},
+						"services": {
+							Type:        schema.TypeString,
+							Description: "The services available down the rewrite path (ex: SIP+D2U).",
+							Optional:    true,
+							Default:     "",
+						},
+						"regexp": {
+							Type:        schema.TypeString,
+							Description: "The substitution expression applied to the original string.",
+							Optional:    true,
+							Default:     "",
+						},
+						"replacement": {
+							Type:        schema.TypeString,
+							Description: "The next domain name to query for (Default: '.' when using a regexp).",
+							Optional:    true,
+							Default:     ".",
+						},
+					},
+				},
+			},
+			"sshfp": {
+				Type:        schema.TypeList,
+				Description: "The SSHFP record data (Required for SSHFP records).",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:         schema.TypeInt,
+							Description:  "The algorithm of the SSH public key (Supported: 1 for RSA, 2 for DSA, 3 for ECDSA, 4 for Ed25519, 6 for Ed448).",
+							ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 6}),
+							Required:     true,
+						},
+						"fingerprint_type": {
+							Type:         schema.TypeInt,
+							Description:  "The type of the fingerprint (Supported: 1 for SHA-1, 2 for SHA-256).",
+							ValidateFunc: validation.IntInSlice([]int{1, 2}),
+							Required:     true,
+						},
+						"fingerprint": {
+							Type:         schema.TypeString,
+							Description:  "The hexadecimal fingerprint of the SSH public key.",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9A-Fa-f]+$"), "Unsupported SSHFP fingerprint, hexadecimal string expected."),
+							Required:     true,
+						},
+					},
+				},
+			},
+			"tlsa": {
+				Type:        schema.TypeList,
+				Description: "The TLSA record data (Required for TLSA records).",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"usage": {
+							Type:         schema.TypeInt,
+							Description:  "The certificate usage (Supported: 0 for PKIX-TA, 1 for PKIX-EE, 2 for DANE-TA, 3 for DANE-EE).",
+							ValidateFunc: validation.IntBetween(0, 3),
+							Required:     true,
+						},
+						"selector": {
+							Type:         schema.TypeInt,
+							Description:  "The part of the certificate to match (Supported: 0 for the full certificate, 1 for the public key).",
+							ValidateFunc: validation.IntBetween(0, 1),
+							Required:     true,
+						},
+						"matching_type": {
+							Type:         schema.TypeInt,
+							Description:  "The matching type of the certificate data (Supported: 0 for exact match, 1 for SHA-256, 2 for SHA-512).",
+							ValidateFunc: validation.IntBetween(0, 2),
+							Required:     true,
+						},
+						"certificate": {
+							Type:         schema.TypeString,
+							Description:  "The hexadecimal certificate association data.",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9A-Fa-f]+$"), "Unsupported TLSA certificate data, hexadecimal string expected."),
+							Required:     true,
+						},
+					},
+				},
+			},
+			"ds": {
+				Type:        schema.TypeList,
+				Description: "The DS record data (Required for DS records).",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_tag": {
+							Type:         schema.TypeInt,
+							Description:  "The key tag of the DNSKEY referred to by the DS record.",
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Required:     true,
+						},
+						"algorithm": {
+							Type:         schema.TypeInt,
+							Description:  "The algorithm of the DNSKEY referred to by the DS record (ex: 8 for RSASHA256, 13 for ECDSAP256SHA256).",
+							ValidateFunc: validation.IntBetween(0, 255),
+							Required:     true,
+						},
+						"digest_type": {
+							Type:         schema.TypeInt,
+							Description:  "The type of the digest (Supported: 1 for SHA-1, 2 for SHA-256, 4 for SHA-384).",
+							ValidateFunc: validation.IntInSlice([]int{1, 2, 4}),
+							Required:     true,
+						},
+						"digest": {
+							Type:         schema.TypeString,
+							Description:  "The hexadecimal digest of the DNSKEY referred to by the DS record.",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9A-Fa-f]+$"), "Unsupported DS digest, hexadecimal string expected."),
+							Required:     true,
+						},
+					},
+				},
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -69,6 +307,12 @@ func resourcednsrr() *schema.Resource {
 				Optional:    true,
 				Default:     3600,
 			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Adopt the existing RR matching the server, view, name, type and value instead of failing, reconciling its TTL (Default: false).",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -89,47 +333,153 @@ func resourcednsrrvalidatetype(v interface{}, _ string) ([]string, []error) {
 		return nil, nil
 	case "NS":
 		return nil, nil
+	case "MX":
+		return nil, nil
+	case "SRV":
+		return nil, nil
+	case "CAA":
+		return nil, nil
+	case "NAPTR":
+		return nil, nil
+	case "SSHFP":
+		return nil, nil
+	case "TLSA":
+		return nil, nil
+	case "DS":
+		return nil, nil
 	default:
 		return nil, []error{fmt.Errorf("Unsupported RR type.")}
 	}
 }
 
-func resourcednsrrExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	s := meta.(*SOLIDserver)
+// Check that the RR has either a value or the type specific attributes matching its type
+func resourcednsrrCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
 
-	// Building parameters
-	parameters := url.Values{}
+	rrType := strings.ToUpper(d.Get("type").(string))
+	_, structured := dnsrrtypeattributes[rrType]
 
-	// Sending the read request
-	//parameters.Add("rr_id", d.Id())
-	//log.Printf("[DEBUG] Checking existence of RR (oid): %s\n", d.Id())
-	//resp, body, err := s.Request("get", "rest/dns_rr_info", &parameters)
+	for t := range dnsrrtypeattributes {
+		if t != rrType && len(d.Get(strings.ToLower(t)).([]interface{})) > 0 {
+			return fmt.Errorf("SOLIDServer - RR: %s of type %s can't have %s attributes", d.Get("name").(string), rrType, strings.ToLower(t))
+		}
+	}
 
-	// Attempt to not rely on the ID that may change due to DNS behavior
-	whereClause := "dns_name='" + d.Get("dnsserver").(string) + "' AND rr_full_name='" + d.Get("name").(string) + "' AND rr_type='" + strings.ToUpper(d.Get("type").(string))
+	if structured {
+		if len(d.Get(strings.ToLower(rrType)).([]interface{})) == 0 {
+			return fmt.Errorf("SOLIDServer - RR: %s of type %s requires %s attributes", d.Get("name").(string), rrType, strings.ToLower(rrType))
+		}
 
-	// FIXME - Must convert IPv6 short to long
-	if strings.ToUpper(d.Get("type").(string)) == "AAAA" {
-		value := shortip6tolongip6(d.Get("value").(string))
-		log.Printf("[DEBUG] SOLIDServer - Using Expanded IPv6 format: %s\n", value)
-		whereClause += "' AND value1='" + value + "' "
-	} else {
-		whereClause += "' AND value1='" + d.Get("value").(string) + "' "
+		if d.NewValueKnown("value") && d.Get("value").(string) != "" {
+			return fmt.Errorf("SOLIDServer - RR: %s of type %s can't have a value, use its %s attributes", d.Get("name").(string), rrType, strings.ToLower(rrType))
+		}
+	} else if d.NewValueKnown("value") && d.Get("value").(string) == "" {
+		return fmt.Errorf("SOLIDServer - RR: %s of type %s requires a value", d.Get("name").(string), rrType)
+	}
+
+	return nil
+}
+
+// Return the value1 to valueN fields of the RR from its value or its type specific attributes
+func resourcednsrrvalues(d *schema.ResourceData) []string {
+	rrType := strings.ToUpper(d.Get("type").(string))
+	attributes, structured := dnsrrtypeattributes[rrType]
+	values := []string{}
+
+	if !structured {
+		return []string{d.Get("value").(string)}
+	}
+
+	blocks := d.Get(strings.ToLower(rrType)).([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return values
+	}
+
+	block := blocks[0].(map[string]interface{})
+
+	for _, attribute := range attributes {
+		values = append(values, fmt.Sprint(block[attribute]))
+	}
+
+	return values
+}
+
+// Set the value or the type specific attributes of the RR from its retrieved value1 to valueN fields
+func resourcednsrrsetvalues(d *schema.ResourceData, info map[string]interface{}) {
+	rrType := strings.ToUpper(info["rr_type"].(string))
+	attributes, structured := dnsrrtypeattributes[rrType]
+
+	if !structured {
+		if rrType == "AAAA" {
+			d.Set("value", longip6toshortip6(info["value1"].(string)))
+		} else {
+			d.Set("value", info["value1"].(string))
+		}
+
+		return
+	}
+
+	block := make(map[string]interface{})
+	blockSchema := resourcednsrr().Schema[strings.ToLower(rrType)].Elem.(*schema.Resource).Schema
+
+	for i, attribute := range attributes {
+		value, _ := info["value"+strconv.Itoa(i+1)].(string)
+
+		if blockSchema[attribute].Type == schema.TypeInt {
+			block[attribute], _ = strconv.Atoi(value)
+		} else {
+			block[attribute] = value
+		}
+	}
+
+	d.Set(strings.ToLower(rrType), []interface{}{block})
+}
+
+// Build the WHERE clause matching the RR from its server, view, zone, name, type and values
+func resourcednsrrwhereclause(d *schema.ResourceData) string {
+	rrType := strings.ToUpper(d.Get("type").(string))
+	whereClause := "dns_name='" + d.Get("dnsserver").(string) + "' AND rr_full_name='" + d.Get("name").(string) + "' AND rr_type='" + rrType + "'"
+
+	for i, value := range resourcednsrrvalues(d) {
+		// FIXME - Must convert IPv6 short to long
+		if rrType == "AAAA" {
+			value = shortip6tolongip6(value)
+			log.Printf("[DEBUG] SOLIDServer - Using Expanded IPv6 format: %s\n", value)
+		}
+
+		whereClause += " AND value" + strconv.Itoa(i+1) + "='" + value + "'"
 	}
 
 	// Attempt to hande changing RR IDs
 	if len(d.Get("dnsview").(string)) != 0 {
-		whereClause += "AND dnsview_name='" + d.Get("dnsview").(string) + "' "
+		whereClause += " AND dnsview_name='" + d.Get("dnsview").(string) + "'"
 	} else {
-		whereClause += "AND dnsview_name='#' "
+		whereClause += " AND dnsview_name='#'"
 	}
 
 	// Add dnszone parameter if it is supplied
 	if len(d.Get("dnszone").(string)) != 0 {
-		whereClause += "AND dnszone_name='" + d.Get("dnszone").(string) + "' "
+		whereClause += " AND dnszone_name='" + d.Get("dnszone").(string) + "'"
 	}
 
-	parameters.Add("WHERE", whereClause)
+	return whereClause
+}
+
+func resourcednsrrExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+
+	// Sending the read request
+	//parameters.Add("rr_id", d.Id())
+	//log.Printf("[DEBUG] Checking existence of RR (oid): %s\n", d.Id())
+	//resp, body, err := s.Request("get", "rest/dns_rr_info", &parameters)
+
+	// Attempt to not rely on the ID that may change due to DNS behavior
+	parameters.Add("WHERE", resourcednsrrwhereclause(d))
 	resp, body, err := s.Request("get", "rest/dns_rr_list", &parameters)
 
 	if err == nil {
@@ -165,15 +515,34 @@ func resourcednsrrExists(d *schema.ResourceData, meta interface{}) (bool, error)
 func resourcednsrrCreate(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
+	// Adopting the existing RR if required
+	if d.Get("adopt_existing").(bool) {
+		rrID, rrErr := dnsrridbyinfo(d.Get("dnsserver").(string), d.Get("dnsview").(string), d.Get("name").(string), d.Get("type").(string), strings.Join(resourcednsrrvalues(d), " "), meta)
+
+		if rrErr != nil {
+			// Reporting a failure
+			return rrErr
+		}
+
+		if rrID != "" {
+			log.Printf("[DEBUG] SOLIDServer - Adopting RR (oid): %s\n", rrID)
+			d.SetId(rrID)
+			return resourcednsrrUpdate(d, meta)
+		}
+	}
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("add_flag", "new_only")
 	parameters.Add("dns_name", d.Get("dnsserver").(string))
 	parameters.Add("rr_name", d.Get("name").(string))
 	parameters.Add("rr_type", strings.ToUpper(d.Get("type").(string)))
-	parameters.Add("value1", d.Get("value").(string))
 	parameters.Add("rr_ttl", strconv.Itoa(d.Get("ttl").(int)))
 
+	for i, value := range resourcednsrrvalues(d) {
+		parameters.Add("value"+strconv.Itoa(i+1), value)
+	}
+
 	// Add dnsview parameter if it is supplied
 	if len(d.Get("dnsview").(string)) != 0 {
 		parameters.Add("dnsview_name", strings.ToLower(d.Get("dnsview").(string)))
@@ -224,9 +593,12 @@ func resourcednsrrUpdate(d *schema.ResourceData, meta interface{}) error {
 	parameters.Add("dns_name", d.Get("dnsserver").(string))
 	parameters.Add("rr_name", d.Get("name").(string))
 	parameters.Add("rr_type", strings.ToUpper(d.Get("type").(string)))
-	parameters.Add("value1", d.Get("value").(string))
 	parameters.Add("rr_ttl", strconv.Itoa(d.Get("ttl").(int)))
 
+	for i, value := range resourcednsrrvalues(d) {
+		parameters.Add("value"+strconv.Itoa(i+1), value)
+	}
+
 	// Add dnsview parameter if it is supplied
 	if len(d.Get("dnsview").(string)) != 0 {
 		parameters.Add("dnsview_name", strings.ToLower(d.Get("dnsview").(string)))
@@ -323,30 +695,7 @@ func resourcednsrrRead(d *schema.ResourceData, meta interface{}) error {
 	//resp, body, err := s.Request("get", "rest/dns_rr_info", &parameters)
 
 	// Attempt to not rely on the ID that may change due to DNS behavior
-	whereClause := "dns_name='" + d.Get("dnsserver").(string) + "' AND rr_full_name='" + d.Get("name").(string) + "' AND rr_type='" + strings.ToUpper(d.Get("type").(string))
-
-	// FIXME - Must convert IPv6 short to long
-	if strings.ToUpper(d.Get("type").(string)) == "AAAA" {
-		value := shortip6tolongip6(d.Get("value").(string))
-		log.Printf("[DEBUG] SOLIDServer - Using Expanded IPv6 format: %s\n", value)
-		whereClause += "' AND value1='" + value + "' "
-	} else {
-		whereClause += "' AND value1='" + d.Get("value").(string) + "' "
-	}
-
-	// Attempt to hande changing RR IDs
-	if len(d.Get("dnsview").(string)) != 0 {
-		whereClause += "AND dnsview_name='" + d.Get("dnsview").(string) + "' "
-	} else {
-		whereClause += "AND dnsview_name='#' "
-	}
-
-	// Add dnszone parameter if it is supplied
-	if len(d.Get("dnszone").(string)) != 0 {
-		whereClause += "AND dnszone_name='" + d.Get("dnszone").(string) + "' "
-	}
-
-	parameters.Add("WHERE", whereClause)
+	parameters.Add("WHERE", resourcednsrrwhereclause(d))
 	resp, body, err := s.Request("get", "rest/dns_rr_list", &parameters)
 
 	if err == nil {
@@ -365,11 +714,7 @@ func resourcednsrrRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("name", buf[0]["rr_full_name"].(string))
 			d.Set("type", buf[0]["rr_type"].(string))
 
-			if strings.ToUpper(buf[0]["rr_type"].(string)) == "AAAA" {
-				d.Set("value", longip6toshortip6(buf[0]["value1"].(string)))
-			} else {
-				d.Set("value", buf[0]["value1"].(string))
-			}
+			resourcednsrrsetvalues(d, buf[0])
 
 			d.Set("ttl", ttl)
 
@@ -403,6 +748,20 @@ func resourcednsrrRead(d *schema.ResourceData, meta interface{}) error {
 func resourcednsrrImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (dnsserver/dnsview/dnszone/name/type/value) of the RR if required
+	oid, oidErr := importidresolve(d.Id(), "dnsserver/dnsview/dnszone/name/type/value", "RR", func(keys []string) (string, error) {
+		d.Set("dnszone", keys[2])
+
+		return dnsrridbyinfo(keys[0], keys[1], keys[3], keys[4], keys[5], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("rr_id", d.Id())
@@ -422,11 +781,7 @@ func resourcednsrrImportState(d *schema.ResourceData, meta interface{}) ([]*sche
 			d.Set("name", buf[0]["rr_full_name"].(string))
 			d.Set("type", buf[0]["rr_type"].(string))
 
-			if strings.ToUpper(buf[0]["rr_type"].(string)) == "AAAA" {
-				d.Set("value", longip6toshortip6(buf[0]["value1"].(string)))
-			} else {
-				d.Set("value", buf[0]["value1"].(string))
-			}
+			resourcednsrrsetvalues(d, buf[0])
 
 			d.Set("ttl", ttl)
 
@@ -0,0 +1,310 @@
+package solidserver
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func resourcednsrrset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcednsrrsetCreate,
+		Read:   resourcednsrrsetRead,
+		Update: resourcednsrrsetUpdate,
+		Delete: resourcednsrrsetDelete,
+		Exists: resourcednsrrsetExists,
+		Importer: &schema.ResourceImporter{
+			State: resourcednsrrsetImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dnsserver": {
+				Type:        schema.TypeString,
+				Description: "The managed SMART DNS server name, or DNS server name hosting the RRset's zone.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dnsview": {
+				Type:        schema.TypeString,
+				Description: "The View name of the RRset to manage.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
+			"dnszone": {
+				Type:        schema.TypeString,
+				Description: "The Zone name of the RRset to manage.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The Fully Qualified Domain Name of the RRset to manage.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the RRset to manage (Supported: A, AAAA, TXT, NS, PTR, MX, SRV, CAA, NAPTR, SSHFP, TLSA, DS).",
+				ValidateFunc: resourcednsrrsetvalidatetype,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"values": {
+				Type:        schema.TypeSet,
+				Description: "The complete set of values of the RRset, any other value of the name and type is removed.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "The DNS Time To Live of the RRset's records.",
+				Optional:    true,
+				Default:     3600,
+			},
+		},
+	}
+}
+
+func resourcednsrrsetvalidatetype(v interface{}, k string) ([]string, []error) {
+	// A name can't hold several CNAME or DNAME records
+	switch strings.ToUpper(v.(string)) {
+	case "CNAME":
+		return nil, []error{fmt.Errorf("Unsupported RRset type.")}
+	case "DNAME":
+		return nil, []error{fmt.Errorf("Unsupported RRset type.")}
+	default:
+		return resourcednsrrvalidatetype(v, k)
+	}
+}
+
+// Return the key of a RR value used to compare the retrieved values with the expected ones
+func resourcednsrrsetvaluekey(rrType string, value string) string {
+	if strings.ToUpper(rrType) == "AAAA" {
+		return shortip6tolongip6(value)
+	}
+
+	return value
+}
+
+// Return the RRs of the RRset indexed by the key of their value
+func resourcednsrrsetrrs(d *schema.ResourceData, meta interface{}) (map[string]map[string]interface{}, error) {
+	rrs, err := dnsrrlist(d.Get("dnsserver").(string), d.Get("dnsview").(string), d.Get("dnszone").(string), d.Get("name").(string), d.Get("type").(string), meta)
+
+	if err != nil {
+		// Reporting a failure
+		return nil, err
+	}
+
+	res := make(map[string]map[string]interface{})
+
+	for _, rr := range rrs {
+		res[resourcednsrrsetvaluekey(d.Get("type").(string), dnsrrvaluefrominfo(rr))] = rr
+	}
+
+	return res, nil
+}
+
+// Reconcile the RRs of the name and type with the expected set of values and TTL
+// Missing values are added, extra values are removed and the TTL of the others is updated if required
+func resourcednsrrsetreconcile(d *schema.ResourceData, meta interface{}) error {
+	rrType := strings.ToUpper(d.Get("type").(string))
+	expected := make(map[string]string)
+
+	for _, v := range d.Get("values").(*schema.Set).List() {
+		expected[resourcednsrrsetvaluekey(rrType, v.(string))] = v.(string)
+	}
+
+	current, err := resourcednsrrsetrrs(d, meta)
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	for key, value := range expected {
+		var rrID string = ""
+
+		if rr, exist := current[key]; exist {
+			// Leaving the value untouched when its TTL is already the expected one
+			if ttl, _ := strconv.Atoi(rr["ttl"].(string)); ttl == d.Get("ttl").(int) {
+				continue
+			}
+
+			rrID = rr["rr_id"].(string)
+		}
+
+		if _, err := dnsrrset(rrID, d.Get("dnsserver").(string), d.Get("dnsview").(string), d.Get("dnszone").(string), d.Get("name").(string), rrType, value, d.Get("ttl").(int), meta); err != nil {
+			// Reporting a failure
+			return err
+		}
+	}
+
+	// Removing the extra values once the expected ones are registered, avoiding any resolution gap
+	for key, rr := range current {
+		if _, keep := expected[key]; !keep {
+			log.Printf("[DEBUG] SOLIDServer - Removing extra value: %s from RRset: %s (%s)\n", dnsrrvaluefrominfo(rr), d.Get("name").(string), rrType)
+
+			if err := dnsrrdeletebyid(rr["rr_id"].(string), d.Get("dnsview").(string), d.Get("name").(string), meta); err != nil {
+				// Reporting a failure
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourcednsrrsetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	log.Printf("[DEBUG] Checking existence of RRset: %s\n", d.Id())
+
+	current, err := resourcednsrrsetrrs(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return false, err
+	}
+
+	if len(current) == 0 {
+		// Log the error
+		log.Printf("[DEBUG] SOLIDServer - Unable to find RRset: %s\n", d.Id())
+
+		// Unset local ID
+		d.SetId("")
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func resourcednsrrsetCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourcednsrrsetreconcile(d, meta); err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	d.SetId(strings.Join([]string{d.Get("dnsserver").(string), d.Get("dnsview").(string), d.Get("dnszone").(string), d.Get("name").(string), strings.ToUpper(d.Get("type").(string))}, "/"))
+	log.Printf("[DEBUG] SOLIDServer - Created RRset: %s\n", d.Id())
+
+	return nil
+}
+
+func resourcednsrrsetUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourcednsrrsetreconcile(d, meta); err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Updated RRset: %s\n", d.Id())
+
+	return nil
+}
+
+func resourcednsrrsetDelete(d *schema.ResourceData, meta interface{}) error {
+	current, err := resourcednsrrsetrrs(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	for _, rr := range current {
+		if err := dnsrrdeletebyid(rr["rr_id"].(string), d.Get("dnsview").(string), d.Get("name").(string), meta); err != nil {
+			// Reporting a failure
+			return err
+		}
+	}
+
+	// Log deletion
+	log.Printf("[DEBUG] SOLIDServer - Deleted RRset: %s\n", d.Id())
+
+	// Unset local ID
+	d.SetId("")
+
+	// Reporting a success
+	return nil
+}
+
+func resourcednsrrsetRead(d *schema.ResourceData, meta interface{}) error {
+	current, err := resourcednsrrsetrrs(d, meta)
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	if len(current) == 0 {
+		// Do not unset the local ID to avoid inconsistency
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find RRset: %s\n", d.Id())
+	}
+
+	// Keeping the spelling of the expected values matching the retrieved ones
+	expected := make(map[string]string)
+	for _, v := range d.Get("values").(*schema.Set).List() {
+		expected[resourcednsrrsetvaluekey(d.Get("type").(string), v.(string))] = v.(string)
+	}
+
+	values := []interface{}{}
+	ttls := []int{}
+
+	for key, rr := range current {
+		if value, exist := expected[key]; exist {
+			values = append(values, value)
+		} else {
+			values = append(values, dnsrrvaluefrominfo(rr))
+		}
+
+		rrTTL, _ := strconv.Atoi(rr["ttl"].(string))
+		ttls = append(ttls, rrTTL)
+	}
+
+	// Reporting the lowest TTL differing from the expected one, whatever the order of the records
+	sort.Ints(ttls)
+	ttl := d.Get("ttl").(int)
+
+	for _, rrTTL := range ttls {
+		if rrTTL != d.Get("ttl").(int) {
+			ttl = rrTTL
+			break
+		}
+	}
+
+	// Extra values added outside of Terraform are reported and removed on the next apply
+	d.Set("values", schema.NewSet(schema.HashString, values))
+	d.Set("ttl", ttl)
+
+	return nil
+}
+
+func resourcednsrrsetImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keys := strings.SplitN(d.Id(), "/", 5)
+
+	if len(keys) != 5 {
+		return nil, fmt.Errorf("SOLIDServer - Unable to import RRset: %s, expecting a key like dnsserver/dnsview/dnszone/name/type\n", d.Id())
+	}
+
+	d.Set("dnsserver", keys[0])
+	d.Set("dnsview", keys[1])
+	d.Set("dnszone", keys[2])
+	d.Set("name", keys[3])
+	d.Set("type", strings.ToUpper(keys[4]))
+	d.Set("ttl", 0)
+
+	if err := resourcednsrrsetRead(d, meta); err != nil {
+		// Reporting a failure
+		return nil, fmt.Errorf("SOLIDServer - Unable to find and import RRset: %s\n", d.Id())
+	}
+
+	d.SetId(strings.Join(keys[0:4], "/") + "/" + strings.ToUpper(keys[4]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,88 @@
+//go:build all || dns_rrset
+// +build all dns_rrset
+
+// to test only these features: -tags dns_rrset -run="dnsrrset_XX"
+
+package solidserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/satori/go.uuid"
+)
+
+// create A, MX and TXT RRsets
+// + add a value and change the TTL of the A RRset
+func TestAccdnsrrset_01(t *testing.T) {
+	zonename := fmt.Sprintf("01-rrset-%s.local", uuid.NewV4())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Config_TestAccdnsrrset_01(zonename, `"10.0.0.10", "10.0.0.11"`, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("solidserver_dns_rrset.a", "id", "ns.local//"+zonename+"/www."+zonename+"/A"),
+					resource.TestCheckResourceAttr("solidserver_dns_rrset.a", "values.#", "2"),
+					resource.TestCheckResourceAttr("solidserver_dns_rrset.a", "ttl", "3600"),
+					resource.TestCheckResourceAttr("solidserver_dns_rrset.mx", "values.#", "2"),
+					resource.TestCheckResourceAttr("solidserver_dns_rrset.txt", "values.#", "1"),
+				),
+			},
+			{
+				Config: Config_TestAccdnsrrset_01(zonename, `"10.0.0.10", "10.0.0.11", "10.0.0.12"`, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("solidserver_dns_rrset.a", "values.#", "3"),
+					resource.TestCheckResourceAttr("solidserver_dns_rrset.a", "ttl", "600"),
+				),
+			},
+			{
+				ResourceName:      "solidserver_dns_rrset.a",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func Config_TestAccdnsrrset_01(zonename string, values string, ttl int) string {
+	return fmt.Sprintf(`
+    resource "solidserver_dns_zone" "zone" {
+      dnsserver = "ns.local"
+      name      = "%s"
+    }
+
+    resource "solidserver_dns_rrset" "a" {
+      dnsserver = "ns.local"
+      dnszone   = "${solidserver_dns_zone.zone.name}"
+      name      = "www.${solidserver_dns_zone.zone.name}"
+      type      = "A"
+      values    = [%s]
+      ttl       = %d
+    }
+
+    resource "solidserver_dns_rrset" "mx" {
+      dnsserver = "ns.local"
+      dnszone   = "${solidserver_dns_zone.zone.name}"
+      name      = "${solidserver_dns_zone.zone.name}"
+      type      = "MX"
+      values    = ["10 mx1.${solidserver_dns_zone.zone.name}", "20 mx2.${solidserver_dns_zone.zone.name}"]
+    }
+
+    resource "solidserver_dns_rrset" "txt" {
+      dnsserver = "ns.local"
+      dnszone   = "${solidserver_dns_zone.zone.name}"
+      name      = "${solidserver_dns_zone.zone.name}"
+      type      = "TXT"
+      values    = ["v=spf1 mx -all"]
+    }
+`, zonename,
+		values,
+		ttl)
+}
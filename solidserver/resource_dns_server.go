@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"log"
 	"net/url"
-	"regexp"
 	"strings"
 	"time"
 )
@@ -101,7 +100,7 @@ func resourcednsserver() *schema.Resource {
 			},
 			"allow_transfer": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS server for zone transfert (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS server for zone transfert.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -110,7 +109,7 @@ func resourcednsserver() *schema.Resource {
 			},
 			"allow_query": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS server (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS server.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -119,7 +118,7 @@ func resourcednsserver() *schema.Resource {
 			},
 			"allow_recursion": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS server for recursion (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS server for recursion.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -243,8 +242,8 @@ func resourcednsserverCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_transfer ACL
 	allowTransfers := ""
 	for _, allowTransfer := range toStringArray(d.Get("allow_transfer").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS server's allow_transfer parameter")
+		if match, _ := dnsaclmatch(allowTransfer); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS server's allow_transfer parameter")
 		}
 		allowTransfers += allowTransfer + ";"
 	}
@@ -253,8 +252,8 @@ func resourcednsserverCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_query ACL
 	allowQueries := ""
 	for _, allowQuery := range toStringArray(d.Get("allow_query").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS server's allow_query parameter")
+		if match, _ := dnsaclmatch(allowQuery); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS server's allow_query parameter")
 		}
 		allowQueries += allowQuery + ";"
 	}
@@ -263,8 +262,8 @@ func resourcednsserverCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_recursion ACL
 	allowRecursions := ""
 	for _, allowRecursion := range toStringArray(d.Get("allow_recursion").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS server's allow_recursion parameter")
+		if match, _ := dnsaclmatch(allowRecursion); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS server's allow_recursion parameter")
 		}
 		allowRecursions += allowRecursion + ";"
 	}
@@ -365,8 +364,8 @@ func resourcednsserverUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_transfer ACL
 	allowTransfers := ""
 	for _, allowTransfer := range toStringArray(d.Get("allow_transfer").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS server's allow_transfer parameter")
+		if match, _ := dnsaclmatch(allowTransfer); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS server's allow_transfer parameter")
 		}
 		allowTransfers += allowTransfer + ";"
 	}
@@ -375,8 +374,8 @@ func resourcednsserverUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_query ACL
 	allowQueries := ""
 	for _, allowQuery := range toStringArray(d.Get("allow_query").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS server's allow_query parameter")
+		if match, _ := dnsaclmatch(allowQuery); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS server's allow_query parameter")
 		}
 		allowQueries += allowQuery + ";"
 	}
@@ -385,8 +384,8 @@ func resourcednsserverUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_recursion ACL
 	allowRecursions := ""
 	for _, allowRecursion := range toStringArray(d.Get("allow_recursion").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS server's allow_recursion parameter")
+		if match, _ := dnsaclmatch(allowRecursion); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS server's allow_recursion parameter")
 		}
 		allowRecursions += allowRecursion + ";"
 	}
@@ -539,7 +538,7 @@ func resourcednsserverRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_transfer"].(string) != "" {
 				allowTransfers := []string{}
 				for _, allowTransfer := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_transfer"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer.(string)); match == true {
+					if match, _ := dnsaclmatch(allowTransfer.(string)); match == true {
 						allowTransfers = append(allowTransfers, allowTransfer.(string))
 					}
 				}
@@ -550,7 +549,7 @@ func resourcednsserverRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_query"].(string) != "" {
 				allowQueries := []string{}
 				for _, allowQuery := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_query"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery.(string)); match == true {
+					if match, _ := dnsaclmatch(allowQuery.(string)); match == true {
 						allowQueries = append(allowQueries, allowQuery.(string))
 					}
 				}
@@ -561,7 +560,7 @@ func resourcednsserverRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_recursion"].(string) != "" {
 				allowRecursions := []string{}
 				for _, allowRecursion := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_recursion"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion.(string)); match == true {
+					if match, _ := dnsaclmatch(allowRecursion.(string)); match == true {
 						allowRecursions = append(allowRecursions, allowRecursion.(string))
 					}
 				}
@@ -611,6 +610,18 @@ func resourcednsserverRead(d *schema.ResourceData, meta interface{}) error {
 func resourcednsserverImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (name) of the DNS server if required
+	oid, oidErr := importidresolve(d.Id(), "name", "DNS server", func(keys []string) (string, error) {
+		return objectidbywhere("dns_server_list", "dns_id", "dns_name='"+keys[0]+"' AND dns_type!='vdns'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("dns_id", d.Id())
@@ -653,7 +664,7 @@ func resourcednsserverImportState(d *schema.ResourceData, meta interface{}) ([]*
 			if buf[0]["dns_allow_transfer"].(string) != "" {
 				allowTransfers := []string{}
 				for _, allowTransfer := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_transfer"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer.(string)); match == true {
+					if match, _ := dnsaclmatch(allowTransfer.(string)); match == true {
 						allowTransfers = append(allowTransfers, allowTransfer.(string))
 					}
 				}
@@ -664,7 +675,7 @@ func resourcednsserverImportState(d *schema.ResourceData, meta interface{}) ([]*
 			if buf[0]["dns_allow_query"].(string) != "" {
 				allowQueries := []string{}
 				for _, allowQuery := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_query"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery.(string)); match == true {
+					if match, _ := dnsaclmatch(allowQuery.(string)); match == true {
 						allowQueries = append(allowQueries, allowQuery.(string))
 					}
 				}
@@ -675,7 +686,7 @@ func resourcednsserverImportState(d *schema.ResourceData, meta interface{}) ([]*
 			if buf[0]["dns_allow_recursion"].(string) != "" {
 				allowRecursions := []string{}
 				for _, allowRecursion := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_recursion"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion.(string)); match == true {
+					if match, _ := dnsaclmatch(allowRecursion.(string)); match == true {
 						allowRecursions = append(allowRecursions, allowRecursion.(string))
 					}
 				}
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"log"
 	"net/url"
-	"regexp"
 	"strings"
 )
 
@@ -73,7 +72,7 @@ func resourcednssmart() *schema.Resource {
 			},
 			"allow_transfer": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS erver for zone transfert (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS erver for zone transfert.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -82,7 +81,7 @@ func resourcednssmart() *schema.Resource {
 			},
 			"allow_query": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS server (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS server.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -91,7 +90,7 @@ func resourcednssmart() *schema.Resource {
 			},
 			"allow_recursion": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the DNS server for recursion (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the DNS server for recursion.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -195,8 +194,8 @@ func resourcednssmartCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_transfer ACL
 	allowTransfers := ""
 	for _, allowTransfer := range toStringArray(d.Get("allow_transfer").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS SMART's allow_transfer parameter")
+		if match, _ := dnsaclmatch(allowTransfer); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS SMART's allow_transfer parameter")
 		}
 		allowTransfers += allowTransfer + ";"
 	}
@@ -205,8 +204,8 @@ func resourcednssmartCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_query ACL
 	allowQueries := ""
 	for _, allowQuery := range toStringArray(d.Get("allow_query").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS SMART's allow_query parameter")
+		if match, _ := dnsaclmatch(allowQuery); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS SMART's allow_query parameter")
 		}
 		allowQueries += allowQuery + ";"
 	}
@@ -215,8 +214,8 @@ func resourcednssmartCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_recursion ACL
 	allowRecursions := ""
 	for _, allowRecursion := range toStringArray(d.Get("allow_recursion").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS SMART's allow_recursion parameter")
+		if match, _ := dnsaclmatch(allowRecursion); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS SMART's allow_recursion parameter")
 		}
 		allowRecursions += allowRecursion + ";"
 	}
@@ -295,8 +294,8 @@ func resourcednssmartUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_transfer ACL
 	allowTransfers := ""
 	for _, allowTransfer := range toStringArray(d.Get("allow_transfer").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS SMART's allow_transfer parameter")
+		if match, _ := dnsaclmatch(allowTransfer); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS SMART's allow_transfer parameter")
 		}
 		allowTransfers += allowTransfer + ";"
 	}
@@ -305,8 +304,8 @@ func resourcednssmartUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_query ACL
 	allowQueries := ""
 	for _, allowQuery := range toStringArray(d.Get("allow_query").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS SMART's allow_query parameter")
+		if match, _ := dnsaclmatch(allowQuery); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS SMART's allow_query parameter")
 		}
 		allowQueries += allowQuery + ";"
 	}
@@ -315,8 +314,8 @@ func resourcednssmartUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_recursion ACL
 	allowRecursions := ""
 	for _, allowRecursion := range toStringArray(d.Get("allow_recursion").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS SMART's allow_recursion parameter")
+		if match, _ := dnsaclmatch(allowRecursion); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS SMART's allow_recursion parameter")
 		}
 		allowRecursions += allowRecursion + ";"
 	}
@@ -440,7 +439,7 @@ func resourcednssmartRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_transfer"].(string) != "" {
 				allowTransfers := []string{}
 				for _, allowTransfer := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_transfer"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer.(string)); match == true {
+					if match, _ := dnsaclmatch(allowTransfer.(string)); match == true {
 						allowTransfers = append(allowTransfers, allowTransfer.(string))
 					}
 				}
@@ -451,7 +450,7 @@ func resourcednssmartRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_query"].(string) != "" {
 				allowQueries := []string{}
 				for _, allowQuery := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_query"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery.(string)); match == true {
+					if match, _ := dnsaclmatch(allowQuery.(string)); match == true {
 						allowQueries = append(allowQueries, allowQuery.(string))
 					}
 				}
@@ -462,7 +461,7 @@ func resourcednssmartRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dns_allow_recursion"].(string) != "" {
 				allowRecursions := []string{}
 				for _, allowRecursion := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_recursion"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion.(string)); match == true {
+					if match, _ := dnsaclmatch(allowRecursion.(string)); match == true {
 						allowRecursions = append(allowRecursions, allowRecursion.(string))
 					}
 				}
@@ -512,6 +511,18 @@ func resourcednssmartRead(d *schema.ResourceData, meta interface{}) error {
 func resourcednssmartImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (name) of the DNS SMART if required
+	oid, oidErr := importidresolve(d.Id(), "name", "DNS SMART", func(keys []string) (string, error) {
+		return objectidbywhere("dns_server_list", "dns_id", "dns_name='"+keys[0]+"' AND dns_type='vdns'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("dns_id", d.Id())
@@ -554,7 +565,7 @@ func resourcednssmartImportState(d *schema.ResourceData, meta interface{}) ([]*s
 			if buf[0]["dns_allow_transfer"].(string) != "" {
 				allowTransfers := []string{}
 				for _, allowTransfer := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_transfer"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer.(string)); match == true {
+					if match, _ := dnsaclmatch(allowTransfer.(string)); match == true {
 						allowTransfers = append(allowTransfers, allowTransfer.(string))
 					}
 				}
@@ -565,7 +576,7 @@ func resourcednssmartImportState(d *schema.ResourceData, meta interface{}) ([]*s
 			if buf[0]["dns_allow_query"].(string) != "" {
 				allowQueries := []string{}
 				for _, allowQuery := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_query"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery.(string)); match == true {
+					if match, _ := dnsaclmatch(allowQuery.(string)); match == true {
 						allowQueries = append(allowQueries, allowQuery.(string))
 					}
 				}
@@ -576,7 +587,7 @@ func resourcednssmartImportState(d *schema.ResourceData, meta interface{}) ([]*s
 			if buf[0]["dns_allow_recursion"].(string) != "" {
 				allowRecursions := []string{}
 				for _, allowRecursion := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dns_allow_recursion"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion.(string)); match == true {
+					if match, _ := dnsaclmatch(allowRecursion.(string)); match == true {
 						allowRecursions = append(allowRecursions, allowRecursion.(string))
 					}
 				}
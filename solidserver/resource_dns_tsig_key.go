@@ -0,0 +1,344 @@
+package solidserver
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+func resourcednstsigkey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcednstsigkeyCreate,
+		Read:   resourcednstsigkeyRead,
+		Update: resourcednstsigkeyUpdate,
+		Delete: resourcednstsigkeyDelete,
+		Exists: resourcednstsigkeyExists,
+		Importer: &schema.ResourceImporter{
+			State: resourcednstsigkeyImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dnsserver": {
+				Type:        schema.TypeString,
+				Description: "The managed SMART DNS server name, or DNS server name hosting the TSIG key.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the TSIG key.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._\-]*$`), "Unsupported TSIG key name."),
+				Required:     true,
+				ForceNew:     true,
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Description:  "The algorithm of the TSIG key (Supported: hmac-md5, hmac-sha1, hmac-sha224, hmac-sha256, hmac-sha384, hmac-sha512; Default: hmac-sha256).",
+				ValidateFunc: validation.StringInSlice([]string{"hmac-md5", "hmac-sha1", "hmac-sha224", "hmac-sha256", "hmac-sha384", "hmac-sha512"}, false),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "hmac-sha256",
+			},
+			"secret": {
+				Type:         schema.TypeString,
+				Description:  "The base64 encoded secret of the TSIG key, generated when not provided.",
+				ValidateFunc: validation.StringIsBase64,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ForceNew:     false,
+			},
+		},
+	}
+}
+
+// Generate a random base64 encoded secret matching the size of the TSIG algorithm
+func resourcednstsigkeygeneratesecret(algorithm string) (string, error) {
+	secret := make([]byte, dnstsigalgorithms[algorithm])
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("SOLIDServer - Unable to generate the secret of TSIG key (%s)", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(secret), nil
+}
+
+func resourcednstsigkeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnstsigkey_id", d.Id())
+
+	log.Printf("[DEBUG] Checking existence of TSIG key (oid): %s\n", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_tsig_key_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			return true, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				log.Printf("[DEBUG] SOLIDServer - Unable to find TSIG key (oid): %s (%s)\n", d.Id(), errMsg)
+			}
+		} else {
+			log.Printf("[DEBUG] SOLIDServer - Unable to find TSIG key (oid): %s\n", d.Id())
+		}
+
+		// Unset local ID
+		d.SetId("")
+	}
+
+	// Reporting a failure
+	return false, err
+}
+
+func resourcednstsigkeyCreate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Generating the secret if required
+	if d.Get("secret").(string) == "" {
+		secret, err := resourcednstsigkeygeneratesecret(d.Get("algorithm").(string))
+
+		if err != nil {
+			// Reporting a failure
+			return err
+		}
+
+		d.Set("secret", secret)
+	}
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("add_flag", "new_only")
+	parameters.Add("dns_name", d.Get("dnsserver").(string))
+	parameters.Add("dnstsigkey_name", d.Get("name").(string))
+	parameters.Add("dnstsigkey_algorithm", d.Get("algorithm").(string))
+	parameters.Add("dnstsigkey_secret", d.Get("secret").(string))
+
+	// Sending the creation request
+	resp, body, err := s.Request("post", "rest/dns_tsig_key_add", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Created TSIG key (oid): %s\n", oid)
+				d.SetId(oid)
+				return nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to create TSIG key: %s (%s)", d.Get("name").(string), errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to create TSIG key: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednstsigkeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnstsigkey_id", d.Id())
+	parameters.Add("add_flag", "edit_only")
+	parameters.Add("dnstsigkey_algorithm", d.Get("algorithm").(string))
+	parameters.Add("dnstsigkey_secret", d.Get("secret").(string))
+
+	// Sending the update request
+	resp, body, err := s.Request("put", "rest/dns_tsig_key_add", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Updated TSIG key (oid): %s\n", oid)
+				d.SetId(oid)
+				return nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to update TSIG key: %s (%s)", d.Get("name").(string), errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to update TSIG key: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednstsigkeyDelete(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnstsigkey_id", d.Id())
+
+	// Sending the deletion request
+	resp, body, err := s.Request("delete", "rest/dns_tsig_key_delete", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			// Reporting a failure
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return fmt.Errorf("SOLIDServer - Unable to delete TSIG key: %s (%s)", d.Get("name").(string), errMsg)
+				}
+			}
+
+			return fmt.Errorf("SOLIDServer - Unable to delete TSIG key: %s", d.Get("name").(string))
+		}
+
+		// Log deletion
+		log.Printf("[DEBUG] SOLIDServer - Deleted TSIG key (oid): %s\n", d.Id())
+
+		// Unset local ID
+		d.SetId("")
+
+		// Reporting a success
+		return nil
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednstsigkeyRead(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnstsigkey_id", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_tsig_key_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			d.Set("dnsserver", buf[0]["dns_name"].(string))
+			d.Set("name", buf[0]["dnstsigkey_name"].(string))
+			d.Set("algorithm", strings.ToLower(buf[0]["dnstsigkey_algorithm"].(string)))
+
+			// The secret may not be disclosed, keeping the local one in such case
+			if secret, secretExist := buf[0]["dnstsigkey_secret"].(string); secretExist && secret != "" {
+				d.Set("secret", secret)
+			}
+
+			return nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				// Log the error
+				log.Printf("[DEBUG] SOLIDServer - Unable to find TSIG key: %s (%s)\n", d.Get("name"), errMsg)
+			}
+		} else {
+			// Log the error
+			log.Printf("[DEBUG] SOLIDServer - Unable to find TSIG key (oid): %s\n", d.Id())
+		}
+
+		// Do not unset the local ID to avoid inconsistency
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find TSIG key: %s\n", d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+func resourcednstsigkeyImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := meta.(*SOLIDserver)
+
+	// Resolving the natural key (dnsserver/name) of the TSIG key if required
+	oid, oidErr := importidresolve(d.Id(), "dnsserver/name", "TSIG key", func(keys []string) (string, error) {
+		return objectidbywhere("dns_tsig_key_list", "dnstsigkey_id", "dns_name='"+keys[0]+"' AND dnstsigkey_name='"+keys[1]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnstsigkey_id", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_tsig_key_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			d.Set("dnsserver", buf[0]["dns_name"].(string))
+			d.Set("name", buf[0]["dnstsigkey_name"].(string))
+			d.Set("algorithm", strings.ToLower(buf[0]["dnstsigkey_algorithm"].(string)))
+
+			if secret, secretExist := buf[0]["dnstsigkey_secret"].(string); secretExist {
+				d.Set("secret", secret)
+			}
+
+			return []*schema.ResourceData{d}, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				log.Printf("[DEBUG] SOLIDServer - Unable to import TSIG key (oid): %s (%s)\n", d.Id(), errMsg)
+			}
+		} else {
+			log.Printf("[DEBUG] SOLIDServer - Unable to find and import TSIG key (oid): %s\n", d.Id())
+		}
+
+		// Reporting a failure
+		return nil, fmt.Errorf("SOLIDServer - Unable to find and import TSIG key (oid): %s\n", d.Id())
+	}
+
+	// Reporting a failure
+	return nil, err
+}
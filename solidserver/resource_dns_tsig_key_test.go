@@ -0,0 +1,95 @@
+//go:build all || dns_tsig_key
+// +build all dns_tsig_key
+
+// to test only these features: -tags dns_tsig_key -run="dnstsigkey_XX"
+
+package solidserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/satori/go.uuid"
+)
+
+// create TSIG key with a generated secret
+// + replace it with another algorithm
+func TestAccdnstsigkey_01(t *testing.T) {
+	keyname := fmt.Sprintf("01-key-%s", uuid.NewV4())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Config_TestAccdnstsigkey_01(keyname, "hmac-sha256"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("solidserver_dns_tsig_key.key", "id"),
+					resource.TestCheckResourceAttr("solidserver_dns_tsig_key.key", "name", keyname),
+					resource.TestCheckResourceAttr("solidserver_dns_tsig_key.key", "algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttrSet("solidserver_dns_tsig_key.key", "secret"),
+				),
+			},
+			{
+				Config: Config_TestAccdnstsigkey_01(keyname, "hmac-sha512"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("solidserver_dns_tsig_key.key", "algorithm", "hmac-sha512"),
+					resource.TestCheckResourceAttrSet("solidserver_dns_tsig_key.key", "secret"),
+				),
+			},
+			{
+				ResourceName:            "solidserver_dns_tsig_key.key",
+				ImportState:             true,
+				ImportStateId:           "ns.local/" + keyname,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+// create TSIG key with a provided secret
+func TestAccdnstsigkey_02(t *testing.T) {
+	keyname := fmt.Sprintf("02-key-%s", uuid.NewV4())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Config_TestAccdnstsigkey_02(keyname, "c2VjcmV0LXNlY3JldC1zZWNyZXQtc2VjcmV0LTEyMzQ="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("solidserver_dns_tsig_key.key", "secret", "c2VjcmV0LXNlY3JldC1zZWNyZXQtc2VjcmV0LTEyMzQ="),
+				),
+			},
+		},
+	})
+}
+
+func Config_TestAccdnstsigkey_01(keyname string, algorithm string) string {
+	return fmt.Sprintf(`
+    resource "solidserver_dns_tsig_key" "key" {
+      dnsserver = "ns.local"
+      name      = "%s"
+      algorithm = "%s"
+    }
+`, keyname,
+		algorithm)
+}
+
+func Config_TestAccdnstsigkey_02(keyname string, secret string) string {
+	return fmt.Sprintf(`
+    resource "solidserver_dns_tsig_key" "key" {
+      dnsserver = "ns.local"
+      name      = "%s"
+      secret    = "%s"
+    }
+`, keyname,
+		secret)
+}
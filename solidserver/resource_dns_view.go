@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"log"
 	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -71,7 +70,7 @@ func resourcednsview() *schema.Resource {
 			// Views and Servers/SMARTs
 			"allow_transfer": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the view for zone transfert (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the view for zone transfert.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -80,7 +79,7 @@ func resourcednsview() *schema.Resource {
 			},
 			"allow_query": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the view (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the view.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -89,7 +88,7 @@ func resourcednsview() *schema.Resource {
 			},
 			"allow_recursion": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes allowed to query the view for recursion (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the view for recursion.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -99,7 +98,7 @@ func resourcednsview() *schema.Resource {
 			// Views Only
 			"match_clients": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes used to match the clients of the view (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs used to match the clients of the view.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -108,7 +107,7 @@ func resourcednsview() *schema.Resource {
 			},
 			"match_to": {
 				Type:        schema.TypeList,
-				Description: "A list of network prefixes used to match the traffic to the view (named ACL(s) are not supported using this provider).  Use '!' to negate an entry.",
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs used to match the traffic to the view.  Use '!' to negate an entry.",
 				Optional:    true,
 				ForceNew:    false,
 				Elem: &schema.Schema{
@@ -192,8 +191,8 @@ func resourcednsviewCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_transfer ACL
 	allowTransfers := ""
 	for _, allowTransfer := range toStringArray(d.Get("allow_transfer").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view's allow_transfer parameter")
+		if match, _ := dnsaclmatch(allowTransfer); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view's allow_transfer parameter")
 		}
 		allowTransfers += allowTransfer + ";"
 	}
@@ -202,8 +201,8 @@ func resourcednsviewCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_query ACL
 	allowQueries := ""
 	for _, allowQuery := range toStringArray(d.Get("allow_query").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view's allow_query parameter")
+		if match, _ := dnsaclmatch(allowQuery); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view's allow_query parameter")
 		}
 		allowQueries += allowQuery + ";"
 	}
@@ -212,8 +211,8 @@ func resourcednsviewCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_recursion ACL
 	allowRecursions := ""
 	for _, allowRecursion := range toStringArray(d.Get("allow_recursion").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view's allow_recursion parameter")
+		if match, _ := dnsaclmatch(allowRecursion); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view's allow_recursion parameter")
 		}
 		allowRecursions += allowRecursion + ";"
 	}
@@ -222,8 +221,8 @@ func resourcednsviewCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building match_clients ACL
 	matchClients := ""
 	for _, matchClient := range toStringArray(d.Get("match_clients").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, matchClient); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view's match_clients parameter")
+		if match, _ := dnsaclmatch(matchClient); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view's match_clients parameter")
 		}
 		matchClients += matchClient + ";"
 	}
@@ -232,8 +231,8 @@ func resourcednsviewCreate(d *schema.ResourceData, meta interface{}) error {
 	// Building match_to ACL
 	matchTos := ""
 	for _, matchTo := range toStringArray(d.Get("match_to").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, matchTo); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view match_to parameter")
+		if match, _ := dnsaclmatch(matchTo); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view match_to parameter")
 		}
 		matchTos += matchTo + ";"
 	}
@@ -265,11 +264,11 @@ func resourcednsviewCreate(d *schema.ResourceData, meta interface{}) error {
 					if fwdList != "" {
 						return fmt.Errorf("SOLIDServer - Error creating DNS view: %s (Forward mode set to 'none' but forwarders list is not empty).", strings.ToLower(d.Get("name").(string)))
 					}
-					// NOT required at creation time - dnsparamunset(d.Get("dnsserver").(string), oid, "forward", meta)
-					dnsparamset(d.Get("dnsserver").(string), oid, "forwarders", "", meta)
+					// NOT required at creation time - dnsparamunset(d.Get("dnsserver").(string), oid, "", "forward", meta)
+					dnsparamset(d.Get("dnsserver").(string), oid, "", "forwarders", "", meta)
 				} else {
-					dnsparamset(d.Get("dnsserver").(string), oid, "forward", strings.ToLower(d.Get("forward").(string)), meta)
-					dnsparamset(d.Get("dnsserver").(string), oid, "forwarders", fwdList, meta)
+					dnsparamset(d.Get("dnsserver").(string), oid, "", "forward", strings.ToLower(d.Get("forward").(string)), meta)
+					dnsparamset(d.Get("dnsserver").(string), oid, "", "forwarders", fwdList, meta)
 				}
 
 				return nil
@@ -314,8 +313,8 @@ func resourcednsviewUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_transfer ACL
 	allowTransfers := ""
 	for _, allowTransfer := range toStringArray(d.Get("allow_transfer").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view's allow_transfer parameter")
+		if match, _ := dnsaclmatch(allowTransfer); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view's allow_transfer parameter")
 		}
 		allowTransfers += allowTransfer + ";"
 	}
@@ -324,8 +323,8 @@ func resourcednsviewUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_query ACL
 	allowQueries := ""
 	for _, allowQuery := range toStringArray(d.Get("allow_query").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view's allow_query parameter")
+		if match, _ := dnsaclmatch(allowQuery); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view's allow_query parameter")
 		}
 		allowQueries += allowQuery + ";"
 	}
@@ -334,8 +333,8 @@ func resourcednsviewUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building allow_recursion ACL
 	allowRecursions := ""
 	for _, allowRecursion := range toStringArray(d.Get("allow_recursion").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view's allow_recursion parameter")
+		if match, _ := dnsaclmatch(allowRecursion); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view's allow_recursion parameter")
 		}
 		allowRecursions += allowRecursion + ";"
 	}
@@ -344,8 +343,8 @@ func resourcednsviewUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building match_clients ACL
 	matchClients := ""
 	for _, matchClient := range toStringArray(d.Get("match_clients").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, matchClient); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view's match_clients parameter")
+		if match, _ := dnsaclmatch(matchClient); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view's match_clients parameter")
 		}
 		matchClients += matchClient + ";"
 	}
@@ -354,8 +353,8 @@ func resourcednsviewUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Building match_to ACL
 	matchTos := ""
 	for _, matchTo := range toStringArray(d.Get("match_to").([]interface{})) {
-		if match, _ := regexp.MatchString(regexpNetworkAcl, matchTo); match == false {
-			return fmt.Errorf("SOLIDServer - Only network prefixes are supported for DNS view match_to parameter")
+		if match, _ := dnsaclmatch(matchTo); match == false {
+			return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS view match_to parameter")
 		}
 		matchTos += matchTo + ";"
 	}
@@ -387,11 +386,11 @@ func resourcednsviewUpdate(d *schema.ResourceData, meta interface{}) error {
 					if fwdList != "" {
 						return fmt.Errorf("SOLIDServer - Error creating DNS view: %s (Forward mode set to 'none' but forwarders list is not empty).", strings.ToLower(d.Get("name").(string)))
 					}
-					dnsparamunset(d.Get("dnsserver").(string), oid, "forward", meta)
-					dnsparamset(d.Get("dnsserver").(string), oid, "forwarders", "", meta)
+					dnsparamunset(d.Get("dnsserver").(string), oid, "", "forward", meta)
+					dnsparamset(d.Get("dnsserver").(string), oid, "", "forwarders", "", meta)
 				} else {
-					dnsparamset(d.Get("dnsserver").(string), oid, "forward", strings.ToLower(d.Get("forward").(string)), meta)
-					dnsparamset(d.Get("dnsserver").(string), oid, "forwarders", fwdList, meta)
+					dnsparamset(d.Get("dnsserver").(string), oid, "", "forward", strings.ToLower(d.Get("forward").(string)), meta)
+					dnsparamset(d.Get("dnsserver").(string), oid, "", "forwarders", fwdList, meta)
 				}
 				return nil
 			}
@@ -490,7 +489,7 @@ func resourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			// Updating forward mode
-			forward, forwardErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "forward", meta)
+			forward, forwardErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "", "forward", meta)
 			if forwardErr == nil {
 				if forward == "" {
 					d.Set("forward", "none")
@@ -503,7 +502,7 @@ func resourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			// Updating forwarder information
-			forwarders, forwardersErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "forwarders", meta)
+			forwarders, forwardersErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "", "forwarders", meta)
 			if forwardersErr == nil {
 				if forwarders != "" {
 					d.Set("forwarders", toStringArrayInterface(strings.Split(strings.TrimSuffix(forwarders, ";"), ";")))
@@ -520,7 +519,7 @@ func resourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_allow_transfer"].(string) != "" {
 				allowTransfers := []string{}
 				for _, allowTransfer := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_transfer"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer.(string)); match == true {
+					if match, _ := dnsaclmatch(allowTransfer.(string)); match == true {
 						allowTransfers = append(allowTransfers, allowTransfer.(string))
 					}
 				}
@@ -531,7 +530,7 @@ func resourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_allow_query"].(string) != "" {
 				allowQueries := []string{}
 				for _, allowQuery := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_query"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery.(string)); match == true {
+					if match, _ := dnsaclmatch(allowQuery.(string)); match == true {
 						allowQueries = append(allowQueries, allowQuery.(string))
 					}
 				}
@@ -542,7 +541,7 @@ func resourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_allow_recursion"].(string) != "" {
 				allowRecursions := []string{}
 				for _, allowRecursion := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_recursion"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion.(string)); match == true {
+					if match, _ := dnsaclmatch(allowRecursion.(string)); match == true {
 						allowRecursions = append(allowRecursions, allowRecursion.(string))
 					}
 				}
@@ -553,7 +552,7 @@ func resourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_match_clients"].(string) != "" {
 				matchClients := []string{}
 				for _, matchClient := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_match_clients"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, matchClient.(string)); match == true {
+					if match, _ := dnsaclmatch(matchClient.(string)); match == true {
 						matchClients = append(matchClients, matchClient.(string))
 					}
 				}
@@ -563,7 +562,7 @@ func resourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 			if buf[0]["dnsview_match_to"].(string) != "" {
 				matchTos := []string{}
 				for _, matchTo := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_match_to"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, matchTo.(string)); match == true {
+					if match, _ := dnsaclmatch(matchTo.(string)); match == true {
 						matchTos = append(matchTos, matchTo.(string))
 					}
 				}
@@ -612,6 +611,18 @@ func resourcednsviewRead(d *schema.ResourceData, meta interface{}) error {
 func resourcednsviewImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (dnsserver/name) of the DNS view if required
+	oid, oidErr := importidresolve(d.Id(), "dnsserver/name", "DNS view", func(keys []string) (string, error) {
+		return objectidbywhere("dns_view_list", "dnsview_id", "dns_name='"+keys[0]+"' AND dnsview_name='"+keys[1]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("dnsview_id", d.Id())
@@ -639,7 +650,7 @@ func resourcednsviewImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			}
 
 			// Updating forward mode
-			forward, forwardErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "forward", meta)
+			forward, forwardErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "", "forward", meta)
 			if forwardErr == nil {
 				if forward == "" {
 					d.Set("forward", "none")
@@ -652,7 +663,7 @@ func resourcednsviewImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			}
 
 			// Updating forwarder information
-			forwarders, forwardersErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "forwarders", meta)
+			forwarders, forwardersErr := dnsparamget(buf[0]["dns_name"].(string), d.Id(), "", "forwarders", meta)
 			if forwardersErr == nil {
 				if forwarders != "" {
 					d.Set("forwarders", toStringArrayInterface(strings.Split(strings.TrimSuffix(forwarders, ";"), ";")))
@@ -669,7 +680,7 @@ func resourcednsviewImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			if buf[0]["dnsview_allow_transfer"].(string) != "" {
 				allowTransfers := []string{}
 				for _, allowTransfer := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_transfer"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowTransfer.(string)); match == true {
+					if match, _ := dnsaclmatch(allowTransfer.(string)); match == true {
 						allowTransfers = append(allowTransfers, allowTransfer.(string))
 					}
 				}
@@ -680,7 +691,7 @@ func resourcednsviewImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			if buf[0]["dnsview_allow_query"].(string) != "" {
 				allowQueries := []string{}
 				for _, allowQuery := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_query"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowQuery.(string)); match == true {
+					if match, _ := dnsaclmatch(allowQuery.(string)); match == true {
 						allowQueries = append(allowQueries, allowQuery.(string))
 					}
 				}
@@ -691,7 +702,7 @@ func resourcednsviewImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			if buf[0]["dnsview_allow_recursion"].(string) != "" {
 				allowRecursions := []string{}
 				for _, allowRecursion := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_allow_recursion"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, allowRecursion.(string)); match == true {
+					if match, _ := dnsaclmatch(allowRecursion.(string)); match == true {
 						allowRecursions = append(allowRecursions, allowRecursion.(string))
 					}
 				}
@@ -702,7 +713,7 @@ func resourcednsviewImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			if buf[0]["dnsview_match_clients"].(string) != "" {
 				matchClients := []string{}
 				for _, matchClient := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_match_clients"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, matchClient.(string)); match == true {
+					if match, _ := dnsaclmatch(matchClient.(string)); match == true {
 						matchClients = append(matchClients, matchClient.(string))
 					}
 				}
@@ -712,7 +723,7 @@ func resourcednsviewImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			if buf[0]["dnsview_match_to"].(string) != "" {
 				matchTos := []string{}
 				for _, matchTo := range toStringArrayInterface(strings.Split(strings.TrimSuffix(buf[0]["dnsview_match_to"].(string), ";"), ";")) {
-					if match, _ := regexp.MatchString(regexpNetworkAcl, matchTo.(string)); match == true {
+					if match, _ := dnsaclmatch(matchTo.(string)); match == true {
 						matchTos = append(matchTos, matchTo.(string))
 					}
 				}
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -21,6 +22,7 @@ func resourcednszone() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcednszoneImportState,
 		},
+		CustomizeDiff: resourcednszoneCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"dnsserver": {
@@ -50,12 +52,46 @@ func resourcednszone() *schema.Resource {
 				Default:     "",
 			},
 			"type": {
+				Type:             schema.TypeString,
+				Description:      "The type of the zone to create (Supported: Master, Slave, Stub).",
+				ValidateFunc:     resourcednszonevalidatetype,
+				DiffSuppressFunc: resourcediffsuppresscase,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "Master",
+			},
+			"masters": {
+				Type:        schema.TypeList,
+				Description: "The list of IP addresses (Format <IP>:<Port>) of the master servers of the zone (Required for Slave and Stub zones).",
+				Optional:    true,
+				ForceNew:    false,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(regexpMasterIPPort), "Only IP:Port format is supported"),
+				},
+			},
+			"tsig_key": {
+				Type:        schema.TypeString,
+				Description: "The name of the TSIG key authenticating the transfers from the master servers (Slave and Stub zones only).",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"transfer_source": {
 				Type:         schema.TypeString,
-				Description:  "The type of the zone to create (Supported: Master).",
-				ValidateFunc: resourcednszonevalidatetype,
+				Description:  "The local IP address used to transfer the zone from the master servers (Slave and Stub zones only).",
+				ValidateFunc: validation.Any(validation.IsIPv4Address, validation.StringIsEmpty),
 				Optional:     true,
-				ForceNew:     true,
-				Default:      "Master",
+				ForceNew:     false,
+				Default:      "",
+			},
+			"transfer_source_port": {
+				Type:         schema.TypeInt,
+				Description:  "The local port used to transfer the zone from the master servers, 0 for any (Slave and Stub zones only).",
+				ValidateFunc: validation.IntBetween(0, 65535),
+				Optional:     true,
+				ForceNew:     false,
+				Default:      0,
 			},
 			"createptr": {
 				Type:        schema.TypeBool,
@@ -81,6 +117,173 @@ func resourcednszone() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"dnssec": {
+				Type:        schema.TypeList,
+				Description: "The DNSSEC signing configuration of the zone, the zone is signed when set.",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ksk_algorithm": {
+							Type:             schema.TypeString,
+							Description:      "The algorithm of the key signing key (Supported: RSASHA256, RSASHA512, ECDSAP256SHA256, ECDSAP384SHA384, ED25519; Default: RSASHA256).",
+							ValidateFunc:     validation.StringInSlice([]string{"RSASHA256", "RSASHA512", "ECDSAP256SHA256", "ECDSAP384SHA384", "ED25519"}, true),
+							DiffSuppressFunc: resourcediffsuppresscase,
+							Optional:         true,
+							Default:          "RSASHA256",
+						},
+						"ksk_size": {
+							Type:         schema.TypeInt,
+							Description:  "The size in bits of the key signing key, ignored by elliptic curve algorithms (Default: 2048).",
+							ValidateFunc: validation.IntBetween(1024, 4096),
+							Optional:     true,
+							Default:      2048,
+						},
+						"ksk_rollover": {
+							Type:         schema.TypeInt,
+							Description:  "The rollover period in days of the key signing key (Default: 365).",
+							ValidateFunc: validation.IntAtLeast(1),
+							Optional:     true,
+							Default:      365,
+						},
+						"zsk_algorithm": {
+							Type:             schema.TypeString,
+							Description:      "The algorithm of the zone signing key (Supported: RSASHA256, RSASHA512, ECDSAP256SHA256, ECDSAP384SHA384, ED25519; Default: RSASHA256).",
+							ValidateFunc:     validation.StringInSlice([]string{"RSASHA256", "RSASHA512", "ECDSAP256SHA256", "ECDSAP384SHA384", "ED25519"}, true),
+							DiffSuppressFunc: resourcediffsuppresscase,
+							Optional:         true,
+							Default:          "RSASHA256",
+						},
+						"zsk_size": {
+							Type:         schema.TypeInt,
+							Description:  "The size in bits of the zone signing key, ignored by elliptic curve algorithms (Default: 1024).",
+							ValidateFunc: validation.IntBetween(1024, 4096),
+							Optional:     true,
+							Default:      1024,
+						},
+						"zsk_rollover": {
+							Type:         schema.TypeInt,
+							Description:  "The rollover period in days of the zone signing key (Default: 30).",
+							ValidateFunc: validation.IntAtLeast(1),
+							Optional:     true,
+							Default:      30,
+						},
+					},
+				},
+			},
+			"ds_records": {
+				Type:        schema.TypeList,
+				Description: "The DS records of the key signing keys of the signed zone, to be published in its parent zone.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_tag": {
+							Type:        schema.TypeInt,
+							Description: "The key tag of the key signing key.",
+							Computed:    true,
+						},
+						"algorithm": {
+							Type:        schema.TypeInt,
+							Description: "The algorithm number of the key signing key.",
+							Computed:    true,
+						},
+						"digest_type": {
+							Type:        schema.TypeInt,
+							Description: "The type of the digest (2 for SHA-256).",
+							Computed:    true,
+						},
+						"digest": {
+							Type:        schema.TypeString,
+							Description: "The hexadecimal digest of the key signing key.",
+							Computed:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The value of the DS record (Format: <key_tag> <algorithm> <digest_type> <digest>).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"soa": {
+				Type:        schema.TypeList,
+				Description: "The SOA attributes of the zone, the unset ones being inherited (Master zones only).",
+				Optional:    true,
+				ForceNew:    false,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary_ns": {
+							Type:        schema.TypeString,
+							Description: "The FQDN of the primary name server of the zone.",
+							Optional:    true,
+							Default:     "",
+						},
+						"contact": {
+							Type:        schema.TypeString,
+							Description: "The mailbox of the person responsible for the zone, in its domain name form (ex: hostmaster.mycompany.priv).",
+							Optional:    true,
+							Default:     "",
+						},
+						"refresh": {
+							Type:         schema.TypeInt,
+							Description:  "The interval in seconds before the slave servers refresh the zone (Default: 0 for inherited).",
+							ValidateFunc: validation.IntAtLeast(0),
+							Optional:     true,
+							Default:      0,
+						},
+						"retry": {
+							Type:         schema.TypeInt,
+							Description:  "The interval in seconds before the slave servers retry a failed refresh (Default: 0 for inherited).",
+							ValidateFunc: validation.IntAtLeast(0),
+							Optional:     true,
+							Default:      0,
+						},
+						"expire": {
+							Type:         schema.TypeInt,
+							Description:  "The delay in seconds after which the slave servers stop answering for the zone when it can't be refreshed (Default: 0 for inherited).",
+							ValidateFunc: validation.IntAtLeast(0),
+							Optional:     true,
+							Default:      0,
+						},
+						"minimum": {
+							Type:         schema.TypeInt,
+							Description:  "The negative caching TTL in seconds of the zone (Default: 0 for inherited).",
+							ValidateFunc: validation.IntAtLeast(0),
+							Optional:     true,
+							Default:      0,
+						},
+					},
+				},
+			},
+			"allow_query": {
+				Type:        schema.TypeList,
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to query the zone. Use '!' to negate an entry.",
+				Optional:    true,
+				ForceNew:    false,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allow_transfer": {
+				Type:        schema.TypeList,
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to transfer the zone. Use '!' to negate an entry.",
+				Optional:    true,
+				ForceNew:    false,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allow_update": {
+				Type:        schema.TypeList,
+				Description: "A list of network prefixes, TSIG keys ('key <name>') or named ACLs allowed to dynamically update the zone (Master zones only). Use '!' to negate an entry.",
+				Optional:    true,
+				ForceNew:    false,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the zone.",
@@ -105,11 +308,276 @@ func resourcednszonevalidatetype(v interface{}, _ string) ([]string, []error) {
 	switch strings.ToLower(v.(string)) {
 	case "master":
 		return nil, nil
+	case "slave":
+		return nil, nil
+	case "stub":
+		return nil, nil
 	default:
 		return nil, []error{fmt.Errorf("Unsupported zone type.")}
 	}
 }
 
+// Check the attributes specific to the type of the zone
+// And recompute the DS records of the zone when its signing configuration changes
+func resourcednszoneCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("type") {
+		zoneType := strings.ToLower(d.Get("type").(string))
+
+		if zoneType == "master" {
+			for _, attribute := range []string{"masters", "tsig_key", "transfer_source", "transfer_source_port"} {
+				if _, ok := d.GetOk(attribute); ok {
+					return fmt.Errorf("SOLIDServer - DNS zone: %s of type %s can't have %s", d.Get("name").(string), d.Get("type").(string), attribute)
+				}
+			}
+		} else {
+			if d.NewValueKnown("masters") && len(d.Get("masters").([]interface{})) == 0 {
+				return fmt.Errorf("SOLIDServer - DNS zone: %s of type %s requires masters", d.Get("name").(string), d.Get("type").(string))
+			}
+
+			if len(d.Get("dnssec").([]interface{})) > 0 {
+				return fmt.Errorf("SOLIDServer - DNS zone: %s of type %s can't be signed, only master zones can", d.Get("name").(string), d.Get("type").(string))
+			}
+
+			for _, attribute := range []string{"soa", "allow_update"} {
+				if _, ok := d.GetOk(attribute); ok {
+					return fmt.Errorf("SOLIDServer - DNS zone: %s of type %s can't have %s, only master zones can", d.Get("name").(string), d.Get("type").(string), attribute)
+				}
+			}
+		}
+	}
+
+	if d.HasChange("dnssec") {
+		return d.SetNewComputed("ds_records")
+	}
+
+	return nil
+}
+
+// Sign, update the signing configuration or unsign the zone depending on its dnssec attributes
+func resourcednszonednssec(d *schema.ResourceData, meta interface{}) error {
+	dnssec := d.Get("dnssec").([]interface{})
+
+	if len(dnssec) > 0 && dnssec[0] != nil {
+		if err := dnszonednssecsign(d.Id(), d.Get("name").(string), dnssec[0].(map[string]interface{}), meta); err != nil {
+			return err
+		}
+	} else if !d.IsNewResource() {
+		if err := dnszonednssecunsign(d.Id(), d.Get("name").(string), meta); err != nil {
+			return err
+		}
+	}
+
+	return resourcednszonedsrecords(d, meta)
+}
+
+// Update the DS records of the zone, the zone publishing no key signing key until it is signed
+func resourcednszonedsrecords(d *schema.ResourceData, meta interface{}) error {
+	if len(d.Get("dnssec").([]interface{})) == 0 {
+		d.Set("ds_records", []interface{}{})
+		return nil
+	}
+
+	records, err := dnszonedsrecords(d.Get("dnsserver").(string), d.Get("dnsview").(string), d.Get("name").(string), meta)
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	d.Set("ds_records", records)
+
+	return nil
+}
+
+// Build the masters statement of the zone (ex: '10.0.0.1 port 53 key tsig-key;')
+func resourcednszonemasters(d *schema.ResourceData) string {
+	masters := ""
+
+	for _, master := range toStringArray(d.Get("masters").([]interface{})) {
+		masters += strings.Replace(master, ":", " port ", 1)
+
+		if d.Get("tsig_key").(string) != "" {
+			masters += " key " + d.Get("tsig_key").(string)
+		}
+
+		masters += ";"
+	}
+
+	return masters
+}
+
+// Set the masters, TSIG key and transfer source of the zone from its retrieved information
+func resourcednszonesetmasters(d *schema.ResourceData, info map[string]interface{}) {
+	masters := []string{}
+	tsigKey := ""
+
+	if statement, _ := info["dnszone_masters"].(string); statement != "" {
+		for _, master := range strings.Split(strings.TrimSuffix(statement, ";"), ";") {
+			fields := strings.Fields(master)
+
+			// Skipping empty entries
+			if len(fields) == 0 {
+				continue
+			}
+
+			address := fields[0]
+			port := "53"
+
+			for i := 1; i+1 < len(fields); i += 2 {
+				switch fields[i] {
+				case "port":
+					port = fields[i+1]
+				case "key":
+					tsigKey = fields[i+1]
+				}
+			}
+
+			masters = append(masters, address+":"+port)
+		}
+	}
+
+	d.Set("masters", toStringArrayInterface(masters))
+	d.Set("tsig_key", tsigKey)
+
+	transferSource, _ := info["dnszone_transfer_source"].(string)
+	transferSourcePort := 0
+
+	if fields := strings.Fields(transferSource); len(fields) == 3 && fields[1] == "port" {
+		transferSource = fields[0]
+		transferSourcePort, _ = strconv.Atoi(fields[2])
+	}
+
+	d.Set("transfer_source", transferSource)
+	d.Set("transfer_source_port", transferSourcePort)
+}
+
+// Build the transfer-source statement of the zone (ex: '10.0.0.53 port 5353')
+func resourcednszonetransfersource(d *schema.ResourceData) string {
+	if d.Get("transfer_source").(string) == "" {
+		return ""
+	}
+
+	if d.Get("transfer_source_port").(int) != 0 {
+		return d.Get("transfer_source").(string) + " port " + strconv.Itoa(d.Get("transfer_source_port").(int))
+	}
+
+	return d.Get("transfer_source").(string)
+}
+
+// Set or unset a parameter of the zone depending on its value
+func resourcednszoneparamset(d *schema.ResourceData, paramKey string, paramValue string, meta interface{}) error {
+	if paramValue == "" {
+		// Nothing to unset on a new zone
+		if !d.IsNewResource() {
+			dnsparamunset(d.Get("dnsserver").(string), "", d.Id(), paramKey, meta)
+		}
+
+		return nil
+	}
+
+	if !dnsparamset(d.Get("dnsserver").(string), "", d.Id(), paramKey, paramValue, meta) {
+		return fmt.Errorf("SOLIDServer - Unable to set parameter: %s of DNS zone: %s", paramKey, d.Get("name").(string))
+	}
+
+	return nil
+}
+
+// Push the ACLs and the SOA attributes of the zone, only the modified ones when the zone already exists
+func resourcednszoneparams(d *schema.ResourceData, meta interface{}) error {
+	for _, acl := range dnszoneaclparams {
+		if !d.IsNewResource() && !d.HasChange(acl) {
+			continue
+		}
+
+		entries := ""
+		for _, entry := range toStringArray(d.Get(acl).([]interface{})) {
+			if match, _ := dnsaclmatch(entry); match == false {
+				return fmt.Errorf("SOLIDServer - Only network prefixes, TSIG keys and named ACLs are supported for DNS zone's %s parameter", acl)
+			}
+			entries += entry + ";"
+		}
+
+		if err := resourcednszoneparamset(d, acl, entries, meta); err != nil {
+			return err
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("soa") {
+		soa := map[string]interface{}{}
+
+		if blocks := d.Get("soa").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+			soa = blocks[0].(map[string]interface{})
+		}
+
+		for attribute, paramKey := range dnszonesoaparams {
+			value := ""
+
+			// Empty and zero values are inherited
+			if v, vExist := soa[attribute]; vExist && fmt.Sprint(v) != "0" {
+				value = fmt.Sprint(v)
+			}
+
+			if err := resourcednszoneparamset(d, paramKey, value, meta); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Retrieve the ACLs and the SOA attributes of the zone
+// The SOA attributes are only retrieved when managed, or when importing the zone if any is set
+func resourcednszonereadparams(d *schema.ResourceData, importing bool, meta interface{}) error {
+	for _, acl := range dnszoneaclparams {
+		entries, err := dnsparamget(d.Get("dnsserver").(string), "", d.Id(), acl, meta)
+
+		if err != nil {
+			// Reporting a failure
+			return err
+		}
+
+		if entries != "" {
+			d.Set(acl, toStringArrayInterface(strings.Split(strings.TrimSuffix(entries, ";"), ";")))
+		} else {
+			d.Set(acl, []interface{}{})
+		}
+	}
+
+	if strings.ToLower(d.Get("type").(string)) != "master" {
+		return nil
+	}
+
+	soa := map[string]interface{}{}
+	soaSchema := resourcednszone().Schema["soa"].Elem.(*schema.Resource).Schema
+	soaSet := false
+
+	for attribute, paramKey := range dnszonesoaparams {
+		value, err := dnsparamget(d.Get("dnsserver").(string), "", d.Id(), paramKey, meta)
+
+		if err != nil {
+			// Reporting a failure
+			return err
+		}
+
+		if value != "" {
+			soaSet = true
+		}
+
+		if soaSchema[attribute].Type == schema.TypeInt {
+			soa[attribute], _ = strconv.Atoi(value)
+		} else {
+			soa[attribute] = value
+		}
+	}
+
+	if len(d.Get("soa").([]interface{})) > 0 || (importing && soaSet) {
+		d.Set("soa", []interface{}{soa})
+	}
+
+	return nil
+}
+
 func resourcednszoneExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	s := meta.(*SOLIDserver)
 
@@ -168,6 +636,12 @@ func resourcednszoneCreate(d *schema.ResourceData, meta interface{}) error {
 	parameters.Add("dnszone_type", strings.ToLower(d.Get("type").(string)))
 	parameters.Add("dnszone_site_id", siteID)
 
+	// Building Masters and Transfer Source Statements of Slave and Stub zones
+	if strings.ToLower(d.Get("type").(string)) != "master" {
+		parameters.Add("dnszone_masters", resourcednszonemasters(d))
+		parameters.Add("dnszone_transfer_source", resourcednszonetransfersource(d))
+	}
+
 	// Building Notify and Also Notify Statements
 	parameters.Add("dnszone_notify", strings.ToLower(d.Get("notify").(string)))
 
@@ -212,7 +686,12 @@ func resourcednszoneCreate(d *schema.ResourceData, meta interface{}) error {
 			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
 				log.Printf("[DEBUG] SOLIDServer - Created DNS zone (oid): %s\n", oid)
 				d.SetId(oid)
-				return nil
+
+				if err := resourcednszoneparams(d, meta); err != nil {
+					return err
+				}
+
+				return resourcednszonednssec(d, meta)
 			}
 		}
 
@@ -252,6 +731,12 @@ func resourcednszoneUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	parameters.Add("dnszone_site_id", siteID)
 
+	// Building Masters and Transfer Source Statements of Slave and Stub zones
+	if strings.ToLower(d.Get("type").(string)) != "master" {
+		parameters.Add("dnszone_masters", resourcednszonemasters(d))
+		parameters.Add("dnszone_transfer_source", resourcednszonetransfersource(d))
+	}
+
 	// Building Notify and Also Notify Statements
 	parameters.Add("dnszone_notify", strings.ToLower(d.Get("notify").(string)))
 
@@ -296,6 +781,15 @@ func resourcednszoneUpdate(d *schema.ResourceData, meta interface{}) error {
 			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
 				log.Printf("[DEBUG] SOLIDServer - Updated DNS zone (oid): %s\n", oid)
 				d.SetId(oid)
+
+				if err := resourcednszoneparams(d, meta); err != nil {
+					return err
+				}
+
+				if d.HasChange("dnssec") {
+					return resourcednszonednssec(d, meta)
+				}
+
 				return nil
 			}
 		}
@@ -382,6 +876,10 @@ func resourcednszoneRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("name", buf[0]["dnszone_name"].(string))
 			d.Set("type", buf[0]["dnszone_type"].(string))
 
+			if strings.ToLower(buf[0]["dnszone_type"].(string)) != "master" {
+				resourcednszonesetmasters(d, buf[0])
+			}
+
 			if buf[0]["dnszone_site_name"].(string) != "#" {
 				d.Set("space", buf[0]["dnszone_site_name"].(string))
 			} else {
@@ -419,7 +917,16 @@ func resourcednszoneRead(d *schema.ResourceData, meta interface{}) error {
 
 			d.Set("class_parameters", computedClassParameters)
 
-			return nil
+			// Updating the signing state and the DS records
+			if signed, signedExist := buf[0]["dnszone_is_signed"].(string); signedExist && signed != "1" {
+				d.Set("dnssec", []interface{}{})
+			}
+
+			if err := resourcednszonereadparams(d, false, meta); err != nil {
+				return err
+			}
+
+			return resourcednszonedsrecords(d, meta)
 		}
 
 		if len(buf) > 0 {
@@ -445,6 +952,23 @@ func resourcednszoneRead(d *schema.ResourceData, meta interface{}) error {
 func resourcednszoneImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (dnsserver/dnsview/name) of the DNS zone if required
+	oid, oidErr := importidresolve(d.Id(), "dnsserver/dnsview/name", "DNS zone", func(keys []string) (string, error) {
+		viewName := keys[1]
+		if viewName == "" {
+			viewName = "#"
+		}
+
+		return objectidbywhere("dns_zone_list", "dnszone_id", "dns_name='"+keys[0]+"' AND dnsview_name='"+viewName+"' AND dnszone_name='"+strings.ToLower(keys[2])+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("dnszone_id", d.Id())
@@ -463,6 +987,10 @@ func resourcednszoneImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			d.Set("name", buf[0]["dnszone_name"].(string))
 			d.Set("type", buf[0]["dnszone_type"].(string))
 
+			if strings.ToLower(buf[0]["dnszone_type"].(string)) != "master" {
+				resourcednszonesetmasters(d, buf[0])
+			}
+
 			if buf[0]["dnszone_site_name"].(string) != "#" {
 				d.Set("space", buf[0]["dnszone_site_name"].(string))
 			} else {
@@ -500,6 +1028,25 @@ func resourcednszoneImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 
 			d.Set("class_parameters", computedClassParameters)
 
+			// Importing the signing state and the DS records, using the default signing configuration
+			if signed, _ := buf[0]["dnszone_is_signed"].(string); signed == "1" {
+				dnssec := make(map[string]interface{})
+
+				for k, v := range resourcednszone().Schema["dnssec"].Elem.(*schema.Resource).Schema {
+					dnssec[k] = v.Default
+				}
+
+				d.Set("dnssec", []interface{}{dnssec})
+			}
+
+			if err := resourcednszonereadparams(d, true, meta); err != nil {
+				return nil, err
+			}
+
+			if err := resourcednszonedsrecords(d, meta); err != nil {
+				return nil, err
+			}
+
 			return []*schema.ResourceData{d}, nil
 		}
 
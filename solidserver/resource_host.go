@@ -0,0 +1,539 @@
+package solidserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"log"
+	"net/url"
+	"regexp"
+)
+
+func resourcehost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcehostCreate,
+		Read:   resourcehostRead,
+		Update: resourcehostUpdate,
+		Delete: resourcehostDelete,
+		Exists: resourcehostExists,
+		Importer: &schema.ResourceImporter{
+			State: resourcehostImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"space": {
+				Type:        schema.TypeString,
+				Description: "The name of the space into which creating the IP and IPv6 addresses of the host.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subnet": {
+				Type:        schema.TypeString,
+				Description: "The name of the subnet into which creating the IP address of the host.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"pool": {
+				Type:        schema.TypeString,
+				Description: "The name of the pool into which creating the IP address of the host.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
+			"request_ip": {
+				Type:         schema.TypeString,
+				Description:  "The optionally requested IP address of the host.",
+				ValidateFunc: validation.SingleIP(),
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+			},
+			"subnet6": {
+				Type:        schema.TypeString,
+				Description: "The name of the IPv6 subnet into which creating the IPv6 address of the host.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"pool6": {
+				Type:        schema.TypeString,
+				Description: "The name of the IPv6 pool into which creating the IPv6 address of the host.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
+			"request_ip6": {
+				Type:             schema.TypeString,
+				Description:      "The optionally requested IPv6 address of the host.",
+				ValidateFunc:     validation.SingleIP(),
+				DiffSuppressFunc: resourcediffsuppressIPv6Format,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "The provisionned IP address of the host.",
+				Computed:    true,
+			},
+			"address6": {
+				Type:        schema.TypeString,
+				Description: "The provisionned IPv6 address of the host.",
+				Computed:    true,
+			},
+			"ip6_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the IPv6 address of the host.",
+				Computed:    true,
+			},
+			"device": {
+				Type:        schema.TypeString,
+				Description: "Device Name to associate with the IP and IPv6 addresses of the host (Require a 'Device Manager' license).",
+				Optional:    true,
+				Default:     "",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The short name or FQDN of the host.",
+				Required:    true,
+			},
+			"mac": {
+				Type:             schema.TypeString,
+				Description:      "The MAC Address of the host.",
+				ValidateFunc:     validation.StringMatch(regexp.MustCompile("^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$"), "Unsupported MAC address format."),
+				Optional:         true,
+				DiffSuppressFunc: resourcediffsuppresscase,
+				Default:          "",
+			},
+			"dns_server": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS server or DNS SMART into which registering the A and AAAA records of the host.",
+				Optional:    true,
+				Default:     "",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view into which registering the DNS records of the host.",
+				Optional:    true,
+				Default:     "",
+			},
+			"dns_zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS zone into which registering the A and AAAA records of the host.",
+				Optional:    true,
+				Default:     "",
+			},
+			"dns_ttl": {
+				Type:        schema.TypeInt,
+				Description: "The DNS Time To Live of the DNS records of the host.",
+				Optional:    true,
+				Default:     3600,
+			},
+			"create_ptr": {
+				Type:        schema.TypeBool,
+				Description: "Register the PTR records of the host along with its A and AAAA records.",
+				Optional:    true,
+				Default:     false,
+			},
+			"class": {
+				Type:        schema.TypeString,
+				Description: "The class associated to the IP and IPv6 addresses of the host.",
+				Optional:    true,
+				Default:     "",
+			},
+			"class_parameters": {
+				Type:        schema.TypeMap,
+				Description: "The class parameters associated to the IP and IPv6 addresses of the host.",
+				Optional:    true,
+				Default:     map[string]string{},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourcehostExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("ip_id", d.Id())
+
+	log.Printf("[DEBUG] Checking existence of host (oid): %s\n", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_address_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			return true, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				// Log the error
+				log.Printf("[DEBUG] SOLIDServer - Unable to find host (oid): %s (%s)\n", d.Id(), errMsg)
+			}
+		} else {
+			// Log the error
+			log.Printf("[DEBUG] SOLIDServer - Unable to find host (oid): %s\n", d.Id())
+		}
+
+		// Unset local ID
+		d.SetId("")
+	}
+
+	return false, err
+}
+
+func resourcehostCreate(d *schema.ResourceData, meta interface{}) error {
+	var deviceID string = ""
+
+	// Gather required ID(s) from provided information
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteErr != nil {
+		// Reporting a failure
+		return siteErr
+	}
+
+	// Retrieving device ID
+	if len(d.Get("device").(string)) > 0 {
+		var deviceErr error = nil
+
+		deviceID, deviceErr = hostdevidbyname(d.Get("device").(string), meta)
+		if deviceErr != nil {
+			// Reporting a failure
+			return deviceErr
+		}
+	}
+
+	// Releasing the quarantined addresses of both subnets whose quarantine period is over
+	if releaseErr := ipaddressreleaseexpired(siteID, d.Get("subnet").(string), false, meta); releaseErr != nil {
+		// Reporting a failure
+		return releaseErr
+	}
+
+	if releaseErr := ipaddressreleaseexpired(siteID, d.Get("subnet6").(string), true, meta); releaseErr != nil {
+		// Reporting a failure
+		return releaseErr
+	}
+
+	// Looking for candidate addresses in both families before allocating anything
+	ipAddresses, ipErr := ipaddresscandidates(siteID, d.Get("subnet").(string), d.Get("pool").(string), d.Get("request_ip").(string), d.Get("name").(string), 0, meta)
+	if ipErr != nil {
+		// Reporting a failure
+		return ipErr
+	}
+
+	ip6Addresses, ip6Err := ip6addresscandidates(siteID, d.Get("subnet6").(string), d.Get("pool6").(string), d.Get("request_ip6").(string), d.Get("name").(string), meta)
+	if ip6Err != nil {
+		// Reporting a failure
+		return ip6Err
+	}
+
+	ipID, address, addErr := ipaddressadd(d, siteID, deviceID, ipAddresses, false, meta)
+	if addErr != nil {
+		// Reporting a failure
+		return addErr
+	}
+
+	ip6ID, address6, add6Err := ipaddressadd(d, siteID, deviceID, ip6Addresses, true, meta)
+	if add6Err != nil {
+		// Rolling back the IP address allocation
+		if err := ipaddressdelete(ipID, false, meta); err != nil {
+			log.Printf("[DEBUG] SOLIDServer - Unable to roll back IP address (oid): %s of host: %s\n", ipID, d.Get("name").(string))
+		}
+
+		// Reporting a failure
+		return add6Err
+	}
+
+	d.SetId(ipID)
+	d.Set("ip6_id", ip6ID)
+	d.Set("address", address)
+	d.Set("address6", address6)
+
+	// Registering the DNS records if required
+	dnsErr := ipaddressdnssync(d, "A", "address", meta)
+	if dnsErr == nil {
+		dnsErr = ipaddressdnssync(d, "AAAA", "address6", meta)
+	}
+
+	if dnsErr != nil {
+		// Rolling back the DNS records and both address allocations
+		ipaddressdnsdelete(d, "A", "address", meta)
+		ipaddressdnsdelete(d, "AAAA", "address6", meta)
+		ipaddressdelete(ip6ID, true, meta)
+		ipaddressdelete(ipID, false, meta)
+
+		// Unset local ID
+		d.SetId("")
+
+		// Reporting a failure
+		return dnsErr
+	}
+
+	return nil
+}
+
+func resourcehostUpdate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	var deviceID string = ""
+
+	// Retrieving device ID
+	if len(d.Get("device").(string)) > 0 {
+		var err error = nil
+
+		deviceID, err = hostdevidbyname(d.Get("device").(string), meta)
+
+		if err != nil {
+			// Reporting a failure
+			return err
+		}
+	}
+
+	if d.HasChange("name") || d.HasChange("device") || d.HasChange("mac") || d.HasChange("class") || d.HasChange("class_parameters") {
+		for _, v6 := range []bool{false, true} {
+			service := "rest/ip_add"
+			prefix := "ip"
+			oid := d.Id()
+
+			if v6 {
+				service = "rest/ip6_address6_add"
+				prefix = "ip6"
+				oid = d.Get("ip6_id").(string)
+			}
+
+			// Building parameters
+			parameters := url.Values{}
+			parameters.Add(prefix+"_id", oid)
+			parameters.Add("add_flag", "edit_only")
+			parameters.Add(prefix+"_name", d.Get("name").(string))
+			parameters.Add("hostdev_id", deviceID)
+			parameters.Add(prefix+"_class_name", d.Get("class").(string))
+
+			if d.Get("mac").(string) != "" {
+				parameters.Add("mac_addr", d.Get("mac").(string))
+			}
+
+			// Building class_parameters
+			parameters.Add(prefix+"_class_parameters", urlfromclassparams(d.Get("class_parameters")).Encode())
+
+			// Sending the update request
+			resp, body, err := s.Request("put", service, &parameters)
+
+			if err != nil {
+				// Reporting a failure
+				return err
+			}
+
+			var buf [](map[string]interface{})
+			json.Unmarshal([]byte(body), &buf)
+
+			// Checking the answer
+			if (resp.StatusCode != 200 && resp.StatusCode != 201) || len(buf) == 0 {
+				// Reporting a failure
+				if len(buf) > 0 {
+					if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+						return fmt.Errorf("SOLIDServer - Unable to update host: %s (%s)", d.Get("name").(string), errMsg)
+					}
+				}
+
+				return fmt.Errorf("SOLIDServer - Unable to update host: %s\n", d.Get("name").(string))
+			}
+
+			log.Printf("[DEBUG] SOLIDServer - Updated %s address (oid): %s of host: %s\n", prefix, oid, d.Get("name").(string))
+		}
+	}
+
+	// Synchronizing the DNS records if required
+	if d.HasChange("dns_server") || d.HasChange("dns_view") || d.HasChange("dns_zone") || d.HasChange("dns_ttl") ||
+		d.HasChange("create_ptr") || d.HasChange("name") {
+		if err := ipaddressdnssync(d, "A", "address", meta); err != nil {
+			// Reporting a failure
+			return err
+		}
+
+		return ipaddressdnssync(d, "AAAA", "address6", meta)
+	}
+
+	return nil
+}
+
+func resourcehostDelete(d *schema.ResourceData, meta interface{}) error {
+	// Deleting the DNS records if any
+	if err := ipaddressdnsdelete(d, "A", "address", meta); err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	if err := ipaddressdnsdelete(d, "AAAA", "address6", meta); err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	// Deleting the IPv6 address, then the IP address
+	if d.Get("ip6_id").(string) != "" {
+		if err := ipaddressdelete(d.Get("ip6_id").(string), true, meta); err != nil {
+			// Reporting a failure
+			return err
+		}
+	}
+
+	if err := ipaddressdelete(d.Id(), false, meta); err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	// Unset local ID
+	d.SetId("")
+
+	// Reporting a success
+	return nil
+}
+
+func resourcehostRead(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("ip_id", d.Id())
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_address_info", &parameters)
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	var buf [](map[string]interface{})
+	json.Unmarshal([]byte(body), &buf)
+
+	// Checking the answer
+	if resp.StatusCode != 200 || len(buf) == 0 {
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				// Log the error
+				log.Printf("[DEBUG] SOLIDServer - Unable to find host: %s (%s)\n", d.Get("name"), errMsg)
+			}
+		} else {
+			// Log the error
+			log.Printf("[DEBUG] SOLIDServer - Unable to find host (oid): %s\n", d.Id())
+		}
+
+		// Do not unset the local ID to avoid inconsistency
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find host: %s\n", d.Get("name").(string))
+	}
+
+	d.Set("space", buf[0]["site_name"].(string))
+	d.Set("subnet", buf[0]["subnet_name"].(string))
+	d.Set("address", hexiptoip(buf[0]["ip_addr"].(string)))
+	d.Set("name", buf[0]["name"].(string))
+
+	if macIgnore, _ := regexp.MatchString("^EIP:", buf[0]["mac_addr"].(string)); !macIgnore {
+		d.Set("mac", buf[0]["mac_addr"].(string))
+	} else {
+		d.Set("mac", "")
+	}
+
+	d.Set("class", buf[0]["ip_class_name"].(string))
+
+	// Updating local class_parameters
+	currentClassParameters := d.Get("class_parameters").(map[string]interface{})
+	retrievedClassParameters, _ := url.ParseQuery(buf[0]["ip_class_parameters"].(string))
+	computedClassParameters := map[string]string{}
+
+	for ck := range currentClassParameters {
+		if rv, rvExist := retrievedClassParameters[ck]; rvExist {
+			computedClassParameters[ck] = rv[0]
+		} else {
+			computedClassParameters[ck] = ""
+		}
+	}
+
+	d.Set("class_parameters", computedClassParameters)
+
+	// Retrieving the IPv6 address of the host
+	parameters = url.Values{}
+	parameters.Add("ip6_id", d.Get("ip6_id").(string))
+
+	resp, body, err = s.Request("get", "rest/ip6_address6_info", &parameters)
+
+	if err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	var buf6 [](map[string]interface{})
+	json.Unmarshal([]byte(body), &buf6)
+
+	if resp.StatusCode != 200 || len(buf6) == 0 {
+		// Log the error
+		log.Printf("[DEBUG] SOLIDServer - Unable to find IPv6 address (oid): %s of host: %s\n", d.Get("ip6_id").(string), d.Get("name").(string))
+
+		// Do not unset the local ID to avoid inconsistency
+
+		// Reporting a failure
+		return fmt.Errorf("SOLIDServer - Unable to find IPv6 address of host: %s\n", d.Get("name").(string))
+	}
+
+	d.Set("subnet6", buf6[0]["subnet6_name"].(string))
+	d.Set("address6", hexip6toip6(buf6[0]["ip6_addr"].(string)))
+
+	return nil
+}
+
+func resourcehostImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var ip6ID string = ""
+
+	// Resolving the natural key (space/address/address6) of both addresses of the host
+	oid, oidErr := importidresolve(d.Id(), "space/address/address6", "host", func(keys []string) (string, error) {
+		siteID, siteErr := ipsiteidbyname(keys[0], meta)
+		if siteID == "" {
+			return "", siteErr
+		}
+
+		var ip6Err error = nil
+
+		ip6ID, ip6Err = ip6addressidbyip6(siteID, shortip6tolongip6(keys[2]), meta)
+		if ip6ID == "" {
+			return "", ip6Err
+		}
+
+		return ipaddressidbyip(siteID, keys[1], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	// An oid only identifies the IP address of the host
+	if ip6ID == "" {
+		return nil, fmt.Errorf("SOLIDServer - Unable to import host: %s, expecting a key like space/address/address6\n", d.Id())
+	}
+
+	d.SetId(oid)
+	d.Set("ip6_id", ip6ID)
+	d.Set("pool", "")
+	d.Set("pool6", "")
+	d.Set("request_ip", "")
+	d.Set("request_ip6", "")
+
+	if err := resourcehostRead(d, meta); err != nil {
+		// Reporting a failure
+		return nil, fmt.Errorf("SOLIDServer - Unable to find and import host: %s (%s)\n", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
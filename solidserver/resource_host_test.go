@@ -0,0 +1,106 @@
+//go:build all || host
+// +build all host
+
+// to test only these features: -tags host -run="host_XX"
+
+package solidserver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/satori/go.uuid"
+)
+
+// create dual-stack host
+// + rename it and change its MAC address
+func TestAcchost_01(t *testing.T) {
+	spacename := fmt.Sprintf("01-space-%s", uuid.NewV4())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: Config_TestAcchost_01(spacename, "host-01", "00:11:22:33:44:55"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("solidserver_host.host", "id"),
+					resource.TestCheckResourceAttrSet("solidserver_host.host", "ip6_id"),
+					resource.TestCheckResourceAttr("solidserver_host.host", "address", "10.0.0.10"),
+					resource.TestCheckResourceAttrSet("solidserver_host.host", "address6"),
+					resource.TestCheckResourceAttr("solidserver_host.host", "subnet6", "subnet6-01"),
+				),
+			},
+			{
+				Config: Config_TestAcchost_01(spacename, "host-01-renamed", "00:11:22:33:44:66"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("solidserver_host.host", "name", "host-01-renamed"),
+					resource.TestCheckResourceAttr("solidserver_host.host", "mac", "00:11:22:33:44:66"),
+					resource.TestCheckResourceAttr("solidserver_host.host", "address", "10.0.0.10"),
+				),
+			},
+			{
+				ResourceName:      "solidserver_host.host",
+				ImportState:       true,
+				ImportStateId:     spacename + "/10.0.0.10/2001:db8::10",
+				ImportStateVerify: true,
+				// The requested addresses aren't part of the host information
+				ImportStateVerifyIgnore: []string{"request_ip", "request_ip6"},
+			},
+		},
+	})
+}
+
+func Config_TestAcchost_01(spacename string, hostname string, mac string) string {
+	return fmt.Sprintf(`
+    %s
+
+    resource "solidserver_ip_subnet" "block" {
+      space            = "${solidserver_ip_space.space.name}"
+      request_ip       = "10.0.0.0"
+      prefix_size      = 8
+      name             = "block-01"
+      terminal         = false
+    }
+
+    resource "solidserver_ip_subnet" "subnet" {
+      space            = "${solidserver_ip_space.space.name}"
+      block            = "${solidserver_ip_subnet.block.name}"
+      request_ip       = "10.0.0.0"
+      prefix_size      = 24
+      name             = "subnet-01"
+    }
+
+    resource "solidserver_ip6_subnet" "block6" {
+      space            = "${solidserver_ip_space.space.name}"
+      request_ip       = "2001:db8::"
+      prefix_size      = 48
+      name             = "block6-01"
+      terminal         = false
+    }
+
+    resource "solidserver_ip6_subnet" "subnet6" {
+      space            = "${solidserver_ip_space.space.name}"
+      block            = "${solidserver_ip6_subnet.block6.name}"
+      request_ip       = "2001:db8::"
+      prefix_size      = 64
+      name             = "subnet6-01"
+    }
+
+    resource "solidserver_host" "host" {
+      space       = "${solidserver_ip_space.space.name}"
+      subnet      = "${solidserver_ip_subnet.subnet.name}"
+      request_ip  = "10.0.0.10"
+      subnet6     = "${solidserver_ip6_subnet.subnet6.name}"
+      request_ip6 = "2001:db8::10"
+      name        = "%s"
+      mac         = "%s"
+    }
+`, Config_CreateSpace(spacename),
+		hostname,
+		mac)
+}
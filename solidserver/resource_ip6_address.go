@@ -8,7 +8,6 @@ import (
 	"log"
 	"net/url"
 	"regexp"
-	"strings"
 )
 
 func resourceip6address() *schema.Resource {
@@ -21,25 +20,26 @@ func resourceip6address() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceip6addressImportState,
 		},
+		CustomizeDiff: resourceip6addressCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"space": {
 				Type:        schema.TypeString,
 				Description: "The name of the space into which creating the IPv6 address.",
 				Required:    true,
-				ForceNew:    true,
+				ForceNew:    false,
 			},
 			"subnet": {
 				Type:        schema.TypeString,
 				Description: "The name of the subnet into which creating the IPv6 address.",
 				Required:    true,
-				ForceNew:    true,
+				ForceNew:    false,
 			},
 			"pool": {
 				Type:        schema.TypeString,
 				Description: "The name of the pool into which creating the IPv6 address.",
 				Optional:    true,
-				ForceNew:    true,
+				ForceNew:    false,
 				Default:     "",
 			},
 			"request_ip": {
@@ -47,14 +47,20 @@ func resourceip6address() *schema.Resource {
 				Description:  "The optionally requested IPv6 address.",
 				ValidateFunc: validation.SingleIP(),
 				Optional:     true,
-				ForceNew:     true,
+				ForceNew:     false,
 				Default:      "",
 			},
+			"generation_mode": {
+				Type:         schema.TypeString,
+				Description:  "The way the IPv6 address is chosen when not requested (Supported: ipam, eui64, random; Default: ipam).",
+				ValidateFunc: validation.StringInSlice([]string{"ipam", "eui64", "random"}, false),
+				Optional:     true,
+				Default:      "ipam",
+			},
 			"address": {
 				Type:        schema.TypeString,
 				Description: "The provisionned IPv6 address.",
 				Computed:    true,
-				ForceNew:    true,
 			},
 			"device": {
 				Type:        schema.TypeString,
@@ -78,6 +84,67 @@ func resourceip6address() *schema.Resource {
 				DiffSuppressFunc: resourcediffsuppresscase,
 				Default:          "",
 			},
+			"dns_server": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS server or DNS SMART hosting the AAAA record of the IPv6 address.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view hosting the AAAA record of the IPv6 address.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"dns_zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS zone hosting the AAAA record of the IPv6 address.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"dns_ttl": {
+				Type:        schema.TypeInt,
+				Description: "The DNS Time To Live of the AAAA and PTR records of the IPv6 address.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     3600,
+			},
+			"create_ptr": {
+				Type:        schema.TypeBool,
+				Description: "Create the PTR record of the IPv6 address along with its AAAA record.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     false,
+			},
+			"release_policy": {
+				Type:         schema.TypeString,
+				Description:  "The policy applied to the IPv6 address on destroy (Supported: delete, quarantine; Default: delete).",
+				ValidateFunc: validation.StringInSlice([]string{"delete", "quarantine"}, false),
+				Optional:     true,
+				Default:      "delete",
+			},
+			"quarantine_period": {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds the released IPv6 address is kept in quarantine before being allocatable again (Default: 86400).",
+				ValidateFunc: validation.IntAtLeast(1),
+				Optional:     true,
+				Default:      86400,
+			},
+			"quarantine_class": {
+				Type:        schema.TypeString,
+				Description: "The class applied to the IPv6 address while in quarantine (Default: keep its class).",
+				Optional:    true,
+				Default:     "",
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Adopt the existing IPv6 address matching the requested IP instead of failing, reconciling its attributes (Default: false).",
+				Optional:    true,
+				Default:     false,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IPv6 address.",
@@ -137,12 +204,48 @@ func resourceip6addressExists(d *schema.ResourceData, meta interface{}) (bool, e
 	return false, err
 }
 
-func resourceip6addressCreate(d *schema.ResourceData, meta interface{}) error {
-	s := meta.(*SOLIDserver)
+func resourceip6addressCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Checking the requested IPv6 address against its subnet and pool bounds
+	if d.Id() == "" || d.HasChange("space") || d.HasChange("subnet") || d.HasChange("pool") || d.HasChange("request_ip") {
+		if err := ipaddresscheckbounds(d, true, meta); err != nil {
+			return err
+		}
+	}
+
+	// Planning the new address of the IPv6 address when it is moved
+	if err := ipaddressplanmove(d, true); err != nil {
+		return err
+	}
+
+	return resourceip6addressplangenerate(d, meta)
+}
 
-	var requestedHexIP string = ip6tohexip6(d.Get("request_ip").(string))
-	var poolInfo map[string]interface{} = nil
-	var ipAddresses []string = nil
+// Plan the address generated from the generation mode, the MAC address and the subnet prefix
+// EUI-64 addresses are known at plan time when the subnet already exists, random ones are marked as new computed
+func resourceip6addressplangenerate(d *schema.ResourceDiff, meta interface{}) error {
+	mode := d.Get("generation_mode").(string)
+
+	if mode == "ipam" || !d.NewValueKnown("generation_mode") || d.Get("request_ip").(string) != "" {
+		return nil
+	}
+
+	// Switching to a generation mode or changing its inputs moves the IPv6 address
+	if d.Id() != "" && !d.HasChange("generation_mode") && !d.HasChange("mac") && !d.HasChange("space") && !d.HasChange("subnet") {
+		return nil
+	}
+
+	if mode == "eui64" && d.NewValueKnown("space") && d.NewValueKnown("subnet") && d.NewValueKnown("mac") {
+		if siteID, _ := ipsiteidbyname(d.Get("space").(string), meta); siteID != "" {
+			if hexAddr := ip6subneteui64(siteID, d.Get("subnet").(string), d.Get("mac").(string), meta); hexAddr != "" {
+				return d.SetNew("address", hexip6toip6(hexAddr))
+			}
+		}
+	}
+
+	return d.SetNewComputed("address")
+}
+
+func resourceip6addressCreate(d *schema.ResourceData, meta interface{}) error {
 	var deviceID string = ""
 
 	// Gather required ID(s) from provided information
@@ -152,24 +255,9 @@ func resourceip6addressCreate(d *schema.ResourceData, meta interface{}) error {
 		return siteErr
 	}
 
-	subnetInfo, subnetErr := ip6subnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
-	if subnetInfo == nil || subnetErr != nil {
-		// Reporting a failure
-		if subnetInfo == nil {
-			return fmt.Errorf("SOLIDServer - Unable to create IP address: %s, unable to find requested network\n", d.Get("name").(string))
-		}
-
-		return subnetErr
-	}
-
-	if len(d.Get("pool").(string)) > 0 {
-		var poolErr error = nil
-
-		poolInfo, poolErr = ip6poolinfobyname(siteID, d.Get("pool").(string), d.Get("subnet").(string), meta)
-		if poolErr != nil {
-			// Reporting a failure
-			return poolErr
-		}
+	// Adopting the existing IPv6 address if required
+	if adopted, adoptErr := resourceip6addressadopt(d, siteID, meta); adopted || adoptErr != nil {
+		return adoptErr
 	}
 
 	// Retrieving device ID
@@ -184,31 +272,104 @@ func resourceip6addressCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Releasing the quarantined IPv6 addresses of the subnet whose quarantine period is over
+	if releaseErr := ipaddressreleaseexpired(siteID, d.Get("subnet").(string), true, meta); releaseErr != nil {
+		// Reporting a failure
+		return releaseErr
+	}
+
 	// Determining if an IP address was submitted in or if we should get one from the IPAM
-	if len(d.Get("request_ip").(string)) > 0 {
-		// Ensure IP Address is within the given subnet start and end IP addresses
-		if strings.Compare(subnetInfo["terminal"].(string), "1") == 0 &&
-			strings.Compare(subnetInfo["start_hex_addr"].(string), requestedHexIP) == -1 &&
-			strings.Compare(requestedHexIP, subnetInfo["end_hex_addr"].(string)) == -1 {
-
-			if poolInfo != nil && (strings.Compare(poolInfo["start_hex_addr"].(string), requestedHexIP) == 1 ||
-				strings.Compare(requestedHexIP, poolInfo["end_hex_addr"].(string)) == 1) {
-				return fmt.Errorf("SOLIDServer - Unable to create IPv6 address: %s, address is out of pool's range\n", d.Get("name").(string))
-			}
+	ipAddresses, ipErr := resourceip6addresscandidates(d, siteID, meta)
 
-			ipAddresses = []string{d.Get("request_ip").(string)}
-		} else {
-			return fmt.Errorf("SOLIDServer - Unable to create IPv6 address: %s, address is out of network's range\n", d.Get("name").(string))
+	if ipErr != nil {
+		// Reporting a failure
+		return ipErr
+	}
+
+	oid, address, addErr := ipaddressadd(d, siteID, deviceID, ipAddresses, true, meta)
+
+	if addErr != nil {
+		// Reporting a failure
+		return addErr
+	}
+
+	d.SetId(oid)
+	d.Set("address", address)
+
+	// Registering the DNS records if required
+	return ipaddressdnssync(d, "AAAA", "address", meta)
+}
+
+// Take over the existing IPv6 address matching the requested IP and reconcile its attributes
+// Return false when adoption is disabled or no such IPv6 address exists
+func resourceip6addressadopt(d *schema.ResourceData, siteID string, meta interface{}) (bool, error) {
+	if !d.Get("adopt_existing").(bool) || d.Get("request_ip").(string) == "" {
+		return false, nil
+	}
+
+	addressID, addressErr := ip6addressidbyip6(siteID, shortip6tolongip6(d.Get("request_ip").(string)), meta)
+	if addressID == "" || addressErr != nil {
+		return false, addressErr
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Adopting IPv6 address (oid): %s\n", addressID)
+	d.SetId(addressID)
+	d.Set("address", d.Get("request_ip").(string))
+
+	return true, resourceip6addressUpdate(d, meta)
+}
+
+// Compute the candidate IPv6 addresses of the resource from its subnet, pool and requested IP or generation mode
+func resourceip6addresscandidates(d *schema.ResourceData, siteID string, meta interface{}) ([]string, error) {
+	if mode := d.Get("generation_mode").(string); mode != "ipam" {
+		if len(d.Get("request_ip").(string)) > 0 || len(d.Get("pool").(string)) > 0 {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, request_ip and pool can't be used with %s generation\n", d.Get("name").(string), mode)
 		}
-	} else {
-		var poolID string = ""
+
+		return ip6addressgenerate(siteID, d.Get("subnet").(string), mode, d.Get("mac").(string), d.Get("name").(string), meta)
+	}
+
+	return ip6addresscandidates(siteID, d.Get("subnet").(string), d.Get("pool").(string), d.Get("request_ip").(string), d.Get("name").(string), meta)
+}
+
+func resourceip6addressUpdate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	var siteID string = ""
+	var ipAddresses []string = []string{""}
+	var deviceID string = ""
+
+	// Retrieving device ID
+	if len(d.Get("device").(string)) > 0 {
+		var err error = nil
+
+		deviceID, err = hostdevidbyname(d.Get("device").(string), meta)
+
+		if err != nil {
+			// Reporting a failure
+			return err
+		}
+	}
+
+	// Determining if the IPv6 address must be moved to another space, subnet, pool or address
+	if resourceip6addressmoverequired(d) {
+		var siteErr error = nil
 		var ipErr error = nil
 
-		if poolInfo != nil {
-			poolID = poolInfo["id"].(string)
+		siteID, siteErr = ipsiteidbyname(d.Get("space").(string), meta)
+
+		if siteErr != nil {
+			// Reporting a failure
+			return siteErr
+		}
+
+		// Releasing the quarantined IPv6 addresses of the subnet whose quarantine period is over
+		if releaseErr := ipaddressreleaseexpired(siteID, d.Get("subnet").(string), true, meta); releaseErr != nil {
+			// Reporting a failure
+			return releaseErr
 		}
 
-		ipAddresses, ipErr = ip6addressfindfree(subnetInfo["id"].(string), poolID, meta)
+		ipAddresses, ipErr = resourceip6addressmovecandidates(d, siteID, meta)
 
 		if ipErr != nil {
 			// Reporting a failure
@@ -219,13 +380,18 @@ func resourceip6addressCreate(d *schema.ResourceData, meta interface{}) error {
 	for i := 0; i < len(ipAddresses); i++ {
 		// Building parameters
 		parameters := url.Values{}
-		parameters.Add("site_id", siteID)
-		parameters.Add("add_flag", "new_only")
+		parameters.Add("ip6_id", d.Id())
+		parameters.Add("add_flag", "edit_only")
 		parameters.Add("ip6_name", d.Get("name").(string))
-		parameters.Add("hostaddr", ipAddresses[i])
 		parameters.Add("hostdev_id", deviceID)
 		parameters.Add("ip6_class_name", d.Get("class").(string))
 
+		// Moving the IPv6 address while keeping the same object
+		if ipAddresses[i] != "" {
+			parameters.Add("site_id", siteID)
+			parameters.Add("hostaddr", ipAddresses[i])
+		}
+
 		if d.Get("mac").(string) != "" {
 			parameters.Add("mac_addr", d.Get("mac").(string))
 		}
@@ -233,8 +399,8 @@ func resourceip6addressCreate(d *schema.ResourceData, meta interface{}) error {
 		// Building class_parameters
 		parameters.Add("ip6_class_parameters", urlfromclassparams(d.Get("class_parameters")).Encode())
 
-		// Sending the creation request
-		resp, body, err := s.Request("post", "rest/ip6_address6_add", &parameters)
+		// Sending the update request
+		resp, body, err := s.Request("put", "rest/ip6_address6_add", &parameters)
 
 		if err == nil {
 			var buf [](map[string]interface{})
@@ -243,123 +409,110 @@ func resourceip6addressCreate(d *schema.ResourceData, meta interface{}) error {
 			// Checking the answer
 			if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
 				if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
-					log.Printf("[DEBUG] SOLIDServer - Created IPv6 address (oid): %s\n", oid)
+					log.Printf("[DEBUG] SOLIDServer - Updated IPv6 address (oid): %s\n", oid)
 					d.SetId(oid)
-					d.Set("address", ipAddresses[i])
-					return nil
-				}
-			} else {
-				if len(buf) > 0 {
-					if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-						log.Printf("[DEBUG] SOLIDServer - Failed IPv6 address registration for IPv6 address: %s with address: %s (%s)\n", d.Get("name").(string), ipAddresses[i], errMsg)
-					} else {
-						log.Printf("[DEBUG] SOLIDServer - Failed IPv6 address registration for IPv6 address: %s with address: %s\n", d.Get("name").(string), ipAddresses[i])
+					if ipAddresses[i] != "" {
+						d.Set("address", hexip6toip6(ip6tohexip6(shortip6tolongip6(ipAddresses[i]))))
 					}
-				} else {
-					log.Printf("[DEBUG] SOLIDServer - Failed IPv6 address registration for IPv6 address: %s with address: %s\n", d.Get("name").(string), ipAddresses[i])
+
+					// Synchronizing the DNS records if required
+					if d.HasChange("dns_server") || d.HasChange("dns_view") || d.HasChange("dns_zone") || d.HasChange("dns_ttl") ||
+						d.HasChange("create_ptr") || d.HasChange("name") || ipAddresses[i] != "" {
+						return ipaddressdnssync(d, "AAAA", "address", meta)
+					}
+
+					return nil
 				}
 			}
-		} else {
+
+			// Trying the next candidate address in case of a move
+			if ipAddresses[i] != "" && i+1 < len(ipAddresses) {
+				log.Printf("[DEBUG] SOLIDServer - Failed to move IPv6 address: %s to address: %s\n", d.Get("name").(string), ipAddresses[i])
+				continue
+			}
+
 			// Reporting a failure
-			return err
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return fmt.Errorf("SOLIDServer - Unable to update IPv6 address: %s (%s)", d.Get("name").(string), errMsg)
+				}
+			}
+
+			return fmt.Errorf("SOLIDServer - Unable to update IPv6 address: %s\n", d.Get("name").(string))
 		}
+
+		// Reporting a failure
+		return err
 	}
 
 	// Reporting a failure
-	return fmt.Errorf("SOLIDServer - Unable to create IPv6 address: %s, unable to find a suitable network or address\n", d.Get("name").(string))
+	return fmt.Errorf("SOLIDServer - Unable to update IPv6 address: %s, unable to find a suitable network or address\n", d.Get("name").(string))
 }
 
-func resourceip6addressUpdate(d *schema.ResourceData, meta interface{}) error {
-	s := meta.(*SOLIDserver)
-
-	var deviceID string = ""
+// Compute the candidate IPv6 addresses of a move, keeping the current address when it falls within the new subnet and pool
+func resourceip6addressmovecandidates(d *schema.ResourceData, siteID string, meta interface{}) ([]string, error) {
+	if d.Get("request_ip").(string) == "" && d.Get("generation_mode").(string) == "ipam" {
+		oldAddress, _ := d.GetChange("address")
 
-	// Retrieving device ID
-	if len(d.Get("device").(string)) > 0 {
-		var err error = nil
+		if _, err := ip6addresscandidates(siteID, d.Get("subnet").(string), d.Get("pool").(string), oldAddress.(string), d.Get("name").(string), meta); err == nil {
+			ipAddresses := []string{oldAddress.(string)}
 
-		deviceID, err = hostdevidbyname(d.Get("device").(string), meta)
+			// The current address may already be used within the new space, falling back on the other candidates
+			if d.HasChange("space") {
+				if candidates, candidatesErr := resourceip6addresscandidates(d, siteID, meta); candidatesErr == nil {
+					ipAddresses = append(ipAddresses, candidates...)
+				}
+			}
 
-		if err != nil {
-			// Reporting a failure
-			return err
+			return ipAddresses, nil
 		}
 	}
 
-	// Building parameters
-	parameters := url.Values{}
-	parameters.Add("ip6_id", d.Id())
-	parameters.Add("add_flag", "edit_only")
-	parameters.Add("ip6_name", d.Get("name").(string))
-	parameters.Add("hostdev_id", deviceID)
-	parameters.Add("ip6_class_name", d.Get("class").(string))
+	return resourceip6addresscandidates(d, siteID, meta)
+}
 
-	if d.Get("mac").(string) != "" {
-		parameters.Add("mac_addr", d.Get("mac").(string))
+// Determine if the space, subnet, pool or requested IP changes require the IPv6 address to be moved
+func resourceip6addressmoverequired(d *schema.ResourceData) bool {
+	// An adopted IPv6 address stays where it is
+	if d.IsNewResource() {
+		return false
 	}
 
-	// Building class_parameters
-	parameters.Add("ip6_class_parameters", urlfromclassparams(d.Get("class_parameters")).Encode())
-
-	// Sending the update request
-	resp, body, err := s.Request("put", "rest/ip6_address6_add", &parameters)
-
-	if err == nil {
-		var buf [](map[string]interface{})
-		json.Unmarshal([]byte(body), &buf)
-
-		// Checking the answer
-		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
-			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
-				log.Printf("[DEBUG] SOLIDServer - Updated IPv6 address (oid): %s\n", oid)
-				d.SetId(oid)
-				return nil
-			}
-		}
+	if d.HasChange("space") || d.HasChange("subnet") || d.HasChange("pool") {
+		return true
+	}
 
-		// Reporting a failure
-		if len(buf) > 0 {
-			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-				return fmt.Errorf("SOLIDServer - Unable to update IPv6 address: %s (%s)", d.Get("name").(string), errMsg)
-			}
-		}
+	// Dropping the requested IP keeps the current address
+	if d.HasChange("request_ip") && d.Get("request_ip").(string) != "" {
+		oldAddress, _ := d.GetChange("address")
+		return ip6tohexip6(shortip6tolongip6(d.Get("request_ip").(string))) != ip6tohexip6(oldAddress.(string))
+	}
 
-		return fmt.Errorf("SOLIDServer - Unable to update IPv6 address: %s\n", d.Get("name").(string))
+	// Generated addresses follow their inputs, switching back to ipam keeps the current address
+	switch d.Get("generation_mode").(string) {
+	case "eui64":
+		return d.HasChange("generation_mode") || d.HasChange("mac")
+	case "random":
+		return d.HasChange("generation_mode")
 	}
 
-	// Reporting a failure
-	return err
+	return false
 }
 
 func resourceip6addressDelete(d *schema.ResourceData, meta interface{}) error {
-	s := meta.(*SOLIDserver)
-
-	// Building parameters
-	parameters := url.Values{}
-	parameters.Add("ip6_id", d.Id())
-
-	// Sending the deletion request
-	resp, body, err := s.Request("delete", "rest/ip6_address6_delete", &parameters)
-
-	if err == nil {
-		var buf [](map[string]interface{})
-		json.Unmarshal([]byte(body), &buf)
+	// Deleting the DNS records if any
+	if err := ipaddressdnsdelete(d, "AAAA", "address", meta); err != nil {
+		// Reporting a failure
+		return err
+	}
 
-		// Checking the answer
-		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+	// Keeping the IPv6 address registered in quarantine if required
+	if d.Get("release_policy").(string) == "quarantine" {
+		if err := ipaddressquarantine(d, true, meta); err != nil {
 			// Reporting a failure
-			if len(buf) > 0 {
-				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-					return fmt.Errorf("SOLIDServer - Unable to delete IPv6 address : %s (%s)", d.Get("name").(string), errMsg)
-				}
-			}
-
-			return fmt.Errorf("SOLIDServer - Unable to delete IPv6 address : %s", d.Get("name").(string))
+			return err
 		}
 
-		// Log deletion
-		log.Printf("[DEBUG] SOLIDServer - Deleted IPv6 address's oid: %s\n", d.Id())
-
 		// Unset local ID
 		d.SetId("")
 
@@ -367,8 +520,16 @@ func resourceip6addressDelete(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	// Reporting a failure
-	return err
+	if err := ipaddressdelete(d.Id(), true, meta); err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	// Unset local ID
+	d.SetId("")
+
+	// Reporting a success
+	return nil
 }
 
 func resourceip6addressRead(d *schema.ResourceData, meta interface{}) error {
@@ -441,6 +602,23 @@ func resourceip6addressRead(d *schema.ResourceData, meta interface{}) error {
 func resourceip6addressImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (space/address) of the IPv6 address if required
+	oid, oidErr := importidresolve(d.Id(), "space/address", "IPv6 address", func(keys []string) (string, error) {
+		siteID, siteErr := ipsiteidbyname(keys[0], meta)
+		if siteID == "" {
+			return "", siteErr
+		}
+
+		return ip6addressidbyip6(siteID, shortip6tolongip6(keys[1]), meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("ip_id", d.Id())
@@ -21,6 +21,7 @@ func resourceip6pool() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceip6poolImportState,
 		},
+		CustomizeDiff: resourceip6poolCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"space": {
@@ -72,6 +73,12 @@ func resourceip6pool() *schema.Resource {
 				Description: "The size prefix of the parent subnet of the pool.",
 				Computed:    true,
 			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Adopt the existing IPv6 pool matching the start and end instead of failing, reconciling its attributes (Default: false).",
+				Optional:    true,
+				Default:     false,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IPv6 pool.",
@@ -130,6 +137,55 @@ func resourceip6poolExists(d *schema.ResourceData, meta interface{}) (bool, erro
 	return false, err
 }
 
+func resourceip6poolCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("space") && !d.HasChange("subnet") && !d.HasChange("start") && !d.HasChange("end") {
+		return nil
+	}
+
+	for _, key := range []string{"space", "subnet", "start", "end"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	startHexAddr := ip6tohexip6(shortip6tolongip6(d.Get("start").(string)))
+	endHexAddr := ip6tohexip6(shortip6tolongip6(d.Get("end").(string)))
+
+	if startHexAddr > endHexAddr {
+		return fmt.Errorf("SOLIDServer - IPv6 pool: %s start address is higher than its end address", d.Get("name").(string))
+	}
+
+	// Parents that can't be resolved yet (ex: created within the same apply) are not checked
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteID == "" || siteErr != nil {
+		return nil
+	}
+
+	subnetInfo, _ := ip6subnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
+	if subnetInfo == nil {
+		return nil
+	}
+
+	// Checking the IPv6 pool against its subnet bounds
+	if startHexAddr < subnetInfo["start_hex_addr"].(string) || endHexAddr > subnetInfo["end_hex_addr"].(string) {
+		return fmt.Errorf("SOLIDServer - IPv6 pool: %s is out of subnet: %s range", d.Get("name").(string), d.Get("subnet").(string))
+	}
+
+	// A pool to adopt doesn't overlap itself
+	excludeID := d.Id()
+
+	if excludeID == "" && d.Get("adopt_existing").(bool) {
+		excludeID, _ = ip6poolidbyrange(subnetInfo["id"].(string), startHexAddr, endHexAddr, meta)
+	}
+
+	// Checking the IPv6 pool against the other pools of the subnet
+	if overlap, _ := ip6pooloverlap(subnetInfo["id"].(string), startHexAddr, endHexAddr, excludeID, meta); overlap != "" {
+		return fmt.Errorf("SOLIDServer - IPv6 pool: %s overlaps IPv6 pool: %s", d.Get("name").(string), overlap)
+	}
+
+	return nil
+}
+
 func resourceip6poolCreate(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
@@ -147,6 +203,11 @@ func resourceip6poolCreate(d *schema.ResourceData, meta interface{}) error {
 		return subnetErr
 	}
 
+	// Adopting the existing IPv6 pool if required
+	if adopted, adoptErr := resourceip6pooladopt(d, subnetInfo, meta); adopted || adoptErr != nil {
+		return adoptErr
+	}
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("add_flag", "new_only")
@@ -208,6 +269,29 @@ func resourceip6poolCreate(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
+// Take over the existing IPv6 pool matching the start and end and reconcile its attributes
+// Return false when adoption is disabled or no such IPv6 pool exists
+func resourceip6pooladopt(d *schema.ResourceData, subnetInfo map[string]interface{}, meta interface{}) (bool, error) {
+	if !d.Get("adopt_existing").(bool) {
+		return false, nil
+	}
+
+	startHexAddr := ip6tohexip6(shortip6tolongip6(d.Get("start").(string)))
+	endHexAddr := ip6tohexip6(shortip6tolongip6(d.Get("end").(string)))
+
+	poolID, poolErr := ip6poolidbyrange(subnetInfo["id"].(string), startHexAddr, endHexAddr, meta)
+	if poolID == "" || poolErr != nil {
+		return false, poolErr
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Adopting IPv6 pool (oid): %s\n", poolID)
+	d.SetId(poolID)
+	d.Set("prefix", subnetInfo["start_addr"].(string)+"/"+strconv.Itoa(subnetInfo["prefix_length"].(int)))
+	d.Set("prefix_size", subnetInfo["prefix_length"].(int))
+
+	return true, resourceip6poolUpdate(d, meta)
+}
+
 func resourceip6poolUpdate(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
@@ -374,6 +458,23 @@ func resourceip6poolRead(d *schema.ResourceData, meta interface{}) error {
 func resourceip6poolImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (space/subnet/name) of the IPv6 pool if required
+	oid, oidErr := importidresolve(d.Id(), "space/subnet/name", "IPv6 pool", func(keys []string) (string, error) {
+		siteID, siteErr := ipsiteidbyname(keys[0], meta)
+		if siteID == "" {
+			return "", siteErr
+		}
+
+		return ip6poolidbyname(siteID, keys[2], keys[1], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("pool6_id", d.Id())
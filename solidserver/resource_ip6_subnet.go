@@ -23,6 +23,7 @@ func resourceip6subnet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceip6subnetImportState,
 		},
+		CustomizeDiff: resourceip6subnetCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"space": {
@@ -51,6 +52,53 @@ func resourceip6subnet() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"validate_nibble_alignment": {
+				Type:        schema.TypeBool,
+				Description: "Refuse a prefix size of the IPv6 subnet that is not nibble aligned (multiple of 4), so that it owns its ip6.arpa reverse zone. The allocation is not affected.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"reverse_zone": {
+				Type:        schema.TypeList,
+				Description: "The ip6.arpa reverse zone(s) to create for the IPv6 subnet.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dnsserver": {
+							Type:        schema.TypeString,
+							Description: "The name of the DNS server or DNS SMART hosting the reverse zone.",
+							Required:    true,
+						},
+						"dnsview": {
+							Type:        schema.TypeString,
+							Description: "The name of the DNS view hosting the reverse zone.",
+							Optional:    true,
+							Default:     "#",
+						},
+						"zones": {
+							Type:        schema.TypeList,
+							Description: "The reverse zones covering the IPv6 subnet.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the reverse zone.",
+										Computed:    true,
+									},
+									"id": {
+										Type:        schema.TypeString,
+										Description: "The ID of the reverse zone.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"prefix": {
 				Type:        schema.TypeString,
 				Description: "The provisionned IPv6 prefix.",
@@ -88,6 +136,33 @@ func resourceip6subnet() *schema.Resource {
 				ForceNew:    true,
 				Default:     true,
 			},
+			"vlan_domain": {
+				Type:        schema.TypeString,
+				Description: "The optional vlan domain's name of the vlan to associate with the IPv6 subnet (terminal subnet only).",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Description: "The optional vlan ID to associate with the IPv6 subnet.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    false,
+			},
+			"vlan_name": {
+				Type:        schema.TypeString,
+				Description: "The optional vlan name to associate with the IPv6 subnet.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    false,
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Adopt the existing IPv6 subnet matching the requested IP and prefix size instead of failing, reconciling its attributes (Default: false).",
+				Optional:    true,
+				Default:     false,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IPv6 subnet.",
@@ -146,6 +221,78 @@ func resourceip6subnetExists(d *schema.ResourceData, meta interface{}) (bool, er
 	return false, err
 }
 
+func resourceip6subnetCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("prefix_size") || !d.NewValueKnown("gateway_offset") {
+		return nil
+	}
+
+	// Checking that the IPv6 subnet owns its ip6.arpa reverse zone
+	if d.Get("validate_nibble_alignment").(bool) && d.Get("prefix_size").(int)%4 != 0 {
+		return fmt.Errorf("SOLIDServer - Prefix size: %d of IPv6 subnet: %s is not nibble aligned (multiple of 4)", d.Get("prefix_size").(int), d.Get("name").(string))
+	}
+
+	if d.Get("prefix_size").(int) < 0 || d.Get("prefix_size").(int) > 128 {
+		return nil
+	}
+
+	size := new(big.Int).Lsh(big.NewInt(1), uint(128-d.Get("prefix_size").(int)))
+	maxOffset := new(big.Int).Sub(size, big.NewInt(1))
+
+	// Checking the gateway offset against the subnet size
+	if goffset := d.Get("gateway_offset").(int); goffset != 0 && big.NewInt(int64(abs(goffset))).Cmp(maxOffset) > 0 {
+		return fmt.Errorf("SOLIDServer - Gateway offset: %d is out of IPv6 subnet: %s range", goffset, d.Get("name").(string))
+	}
+
+	// Checking the requested IPv6 subnet address against its parent block bounds
+	if d.Id() != "" && !d.HasChange("space") && !d.HasChange("block") && !d.HasChange("request_ip") {
+		return nil
+	}
+
+	for _, key := range []string{"space", "block", "request_ip"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	if d.Get("request_ip").(string) == "" {
+		return nil
+	}
+
+	startHexAddr := ip6tohexip6(shortip6tolongip6(d.Get("request_ip").(string)))
+	startAddr, _ := new(big.Int).SetString(startHexAddr, 16)
+
+	if startAddr == nil {
+		return nil
+	}
+
+	if new(big.Int).Mod(startAddr, size).Sign() != 0 {
+		return fmt.Errorf("SOLIDServer - Requested address: %s is not aligned on a /%d boundary", d.Get("request_ip").(string), d.Get("prefix_size").(int))
+	}
+
+	if d.Get("block").(string) == "" {
+		return nil
+	}
+
+	// Parents that can't be resolved yet (ex: created within the same apply) are not checked
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteID == "" || siteErr != nil {
+		return nil
+	}
+
+	blockInfo, _ := ip6subnetinfobyname(siteID, d.Get("block").(string), false, meta)
+	if blockInfo == nil {
+		return nil
+	}
+
+	endHexAddr := fmt.Sprintf("%032x", new(big.Int).Add(startAddr, maxOffset))
+
+	if startHexAddr < blockInfo["start_hex_addr"].(string) || endHexAddr > blockInfo["end_hex_addr"].(string) {
+		return fmt.Errorf("SOLIDServer - Requested address: %s/%d is out of block: %s range", d.Get("request_ip").(string), d.Get("prefix_size").(int), d.Get("block").(string))
+	}
+
+	return nil
+}
+
 func resourceip6subnetCreate(d *schema.ResourceData, meta interface{}) error {
 	blockInfo := make(map[string]interface{})
 	s := meta.(*SOLIDserver)
@@ -158,6 +305,11 @@ func resourceip6subnetCreate(d *schema.ResourceData, meta interface{}) error {
 		return siteErr
 	}
 
+	// Adopting the existing IPv6 subnet if required
+	if adopted, adoptErr := resourceip6subnetadopt(d, siteID, meta); adopted || adoptErr != nil {
+		return adoptErr
+	}
+
 	// If a block is specified, look for free IP subnet within this block
 	if len(d.Get("block").(string)) > 0 {
 		var blockErr error = nil
@@ -210,6 +362,14 @@ func resourceip6subnetCreate(d *schema.ResourceData, meta interface{}) error {
 			parameters.Add("is_terminal", "0")
 		}
 
+		// Associating the IPv6 subnet with a vlan if required
+		if d.Get("vlan_domain").(string) != "" {
+			if vlanErr := subnetvlanparameters(d, &parameters); vlanErr != nil {
+				// Reporting a failure
+				return vlanErr
+			}
+		}
+
 		// Building class_parameters
 		classParameters := url.Values{}
 
@@ -217,16 +377,7 @@ func resourceip6subnetCreate(d *schema.ResourceData, meta interface{}) error {
 		goffset := d.Get("gateway_offset").(int)
 
 		if goffset != 0 {
-			bigStartAddr, _ := new(big.Int).SetString(subnetAddresses[i], 16)
-
-			if goffset > 0 {
-				bigOffset := big.NewInt(int64(goffset))
-				gateway = hexip6toip6(BigIntToHexStr(bigStartAddr.Add(bigStartAddr, bigOffset)))
-			} else {
-				bigEndAddr := bigStartAddr.Add(bigStartAddr, prefix6lengthtosize(int64(d.Get("prefix_size").(int))))
-				bigOffset := big.NewInt(int64(abs(goffset)))
-				gateway = hexip6toip6(BigIntToHexStr(bigEndAddr.Sub(bigEndAddr, bigOffset)))
-			}
+			gateway = ip6subnetgateway(subnetAddresses[i], d.Get("prefix_size").(int), goffset)
 
 			classParameters.Add("gateway", gateway)
 			log.Printf("[DEBUG] SOLIDServer - Subnet computed gateway: %s\n", gateway)
@@ -259,6 +410,16 @@ func resourceip6subnetCreate(d *schema.ResourceData, meta interface{}) error {
 					if goffset != 0 {
 						d.Set("gateway", gateway)
 					}
+
+					// Creating the reverse zone if required
+					if zoneErr := resourceip6subnetreversezoneset(d, siteID, nil, meta); zoneErr != nil {
+						// Rolling back the IPv6 subnet creation
+						resourceip6subnetDelete(d, meta)
+
+						// Reporting a failure
+						return zoneErr
+					}
+
 					return nil
 				}
 			} else {
@@ -282,6 +443,32 @@ func resourceip6subnetCreate(d *schema.ResourceData, meta interface{}) error {
 	return fmt.Errorf("SOLIDServer - Unable to create IPv6 subnet: %s, unable to find a suitable prefix\n", d.Get("name").(string))
 }
 
+// Take over the existing IPv6 subnet matching the requested IP and prefix size and reconcile its attributes
+// Return false when adoption is disabled or no such IPv6 subnet exists
+func resourceip6subnetadopt(d *schema.ResourceData, siteID string, meta interface{}) (bool, error) {
+	if !d.Get("adopt_existing").(bool) || d.Get("request_ip").(string) == "" {
+		return false, nil
+	}
+
+	startHexAddr := ip6tohexip6(shortip6tolongip6(d.Get("request_ip").(string)))
+
+	subnetID, subnetErr := ip6subnetidbyprefix(siteID, startHexAddr, d.Get("prefix_size").(int), d.Get("terminal").(bool), meta)
+	if subnetID == "" || subnetErr != nil {
+		return false, subnetErr
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Adopting IPv6 subnet (oid): %s\n", subnetID)
+	d.SetId(subnetID)
+	d.Set("prefix", hexip6toip6(startHexAddr)+"/"+strconv.Itoa(d.Get("prefix_size").(int)))
+	d.Set("address", hexip6toip6(startHexAddr))
+
+	if goffset := d.Get("gateway_offset").(int); goffset != 0 {
+		d.Set("gateway", ip6subnetgateway(startHexAddr, d.Get("prefix_size").(int), goffset))
+	}
+
+	return true, resourceip6subnetUpdate(d, meta)
+}
+
 func resourceip6subnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
@@ -298,6 +485,14 @@ func resourceip6subnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		parameters.Add("is_terminal", "0")
 	}
 
+	// Updating the vlan association if required
+	if d.HasChange("vlan_domain") || d.HasChange("vlan_id") || d.HasChange("vlan_name") {
+		if vlanErr := subnetvlanparameters(d, &parameters); vlanErr != nil {
+			// Reporting a failure
+			return vlanErr
+		}
+	}
+
 	// Building class_parameters
 	classParameters := url.Values{}
 
@@ -327,6 +522,12 @@ func resourceip6subnetUpdate(d *schema.ResourceData, meta interface{}) error {
 			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
 				log.Printf("[DEBUG] SOLIDServer - Updated IPv6 subnet (oid): %s\n", oid)
 				d.SetId(oid)
+
+				// Replacing the reverse zone if required
+				if d.HasChange("reverse_zone") {
+					return resourceip6subnetreversezoneupdate(d, meta)
+				}
+
 				return nil
 			}
 		}
@@ -345,6 +546,177 @@ func resourceip6subnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
+// Create the reverse zone(s) of the IPv6 subnet if required and store their name and oid
+// Prefixes that are not nibble aligned are covered by the zones of their nibble aligned sub-prefixes
+// The zones listed in existing (name to oid) are kept as is instead of being created
+// Return an error in case of failure
+func resourceip6subnetreversezoneset(d *schema.ResourceData, siteID string, existing map[string]string, meta interface{}) error {
+	blocks := d.Get("reverse_zone").([]interface{})
+
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+
+	block := blocks[0].(map[string]interface{})
+	zoneNames, err := dnsreversezonenames(d.Get("address").(string) + "/" + strconv.Itoa(d.Get("prefix_size").(int)))
+
+	if err != nil {
+		return fmt.Errorf("SOLIDServer - Unable to compute the reverse zone(s) of IPv6 subnet: %s (%s)\n", d.Get("name").(string), err)
+	}
+
+	zones := []interface{}{}
+	created := []interface{}{}
+
+	for _, zoneName := range zoneNames {
+		zoneID, zoneExist := existing[zoneName]
+
+		if !zoneExist {
+			var zoneErr error = nil
+
+			zoneID, zoneErr = dnszoneadd(block["dnsserver"].(string), block["dnsview"].(string), zoneName, siteID, meta)
+
+			if zoneErr != nil {
+				// Rolling back the zones already created
+				resourceip6subnetreversezonedelete(created, meta)
+
+				// Reporting a failure
+				return zoneErr
+			}
+
+			created = append(created, map[string]interface{}{
+				"name": zoneName,
+				"id":   zoneID,
+			})
+		}
+
+		zones = append(zones, map[string]interface{}{
+			"name": zoneName,
+			"id":   zoneID,
+		})
+	}
+
+	block["zones"] = zones
+	d.Set("reverse_zone", []interface{}{block})
+
+	return nil
+}
+
+// Delete the reverse zone(s) of the IPv6 subnet
+// Return an error in case of failure
+func resourceip6subnetreversezonedelete(zones []interface{}, meta interface{}) error {
+	for _, zone := range zones {
+		if zoneID, _ := zone.(map[string]interface{})["id"].(string); zoneID != "" {
+			if err := dnszonedeletebyid(zoneID, meta); err != nil {
+				// Reporting a failure
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reconcile the reverse zone(s) of the IPv6 subnet following a change of its reverse_zone block
+// The new zones are created before the former ones are deleted, the zones already in place are left alone
+// Return an error in case of failure
+func resourceip6subnetreversezoneupdate(d *schema.ResourceData, meta interface{}) error {
+	oldBlocks, newBlocks := d.GetChange("reverse_zone")
+	oldZones := []interface{}{}
+	existing := map[string]string{}
+
+	if blocks := oldBlocks.([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		oldZones = blocks[0].(map[string]interface{})["zones"].([]interface{})
+	}
+
+	if blocks := newBlocks.([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		block := blocks[0].(map[string]interface{})
+		zoneNames, err := dnsreversezonenames(d.Get("address").(string) + "/" + strconv.Itoa(d.Get("prefix_size").(int)))
+
+		if err != nil {
+			return fmt.Errorf("SOLIDServer - Unable to compute the reverse zone(s) of IPv6 subnet: %s (%s)\n", d.Get("name").(string), err)
+		}
+
+		// Looking for the zones already hosted by the DNS server and view
+		for _, zoneName := range zoneNames {
+			zoneID, zoneErr := dnszoneidbyname(block["dnsserver"].(string), block["dnsview"].(string), zoneName, meta)
+
+			if zoneErr != nil {
+				// Reporting a failure
+				return zoneErr
+			}
+
+			if zoneID != "" {
+				existing[zoneName] = zoneID
+			}
+		}
+
+		siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+		if siteErr != nil {
+			// Reporting a failure
+			return siteErr
+		}
+
+		if err := resourceip6subnetreversezoneset(d, siteID, existing, meta); err != nil {
+			// Reporting a failure
+			return err
+		}
+	}
+
+	// Deleting the former zones that are no longer in use
+	unused := []interface{}{}
+
+	for _, zone := range oldZones {
+		zoneName, _ := zone.(map[string]interface{})["name"].(string)
+		zoneID, _ := zone.(map[string]interface{})["id"].(string)
+
+		if existing[zoneName] != zoneID {
+			unused = append(unused, zone)
+		}
+	}
+
+	return resourceip6subnetreversezonedelete(unused, meta)
+}
+
+// Refresh the reverse zone(s) of the IPv6 subnet from the DNS server
+// The reverse_zone block is unset when any of its zones is missing, so that they get recreated
+// Return an error in case of failure
+func resourceip6subnetreversezoneread(d *schema.ResourceData, meta interface{}) error {
+	blocks := d.Get("reverse_zone").([]interface{})
+
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+
+	block := blocks[0].(map[string]interface{})
+	zones := []interface{}{}
+
+	for _, zone := range block["zones"].([]interface{}) {
+		zoneName := zone.(map[string]interface{})["name"].(string)
+		zoneID, zoneErr := dnszoneidbyname(block["dnsserver"].(string), block["dnsview"].(string), zoneName, meta)
+
+		if zoneErr != nil {
+			// Reporting a failure
+			return zoneErr
+		}
+
+		if zoneID == "" {
+			log.Printf("[DEBUG] SOLIDServer - Unable to find reverse zone: %s of IPv6 subnet: %s\n", zoneName, d.Get("name").(string))
+			d.Set("reverse_zone", []interface{}{})
+			return nil
+		}
+
+		zones = append(zones, map[string]interface{}{
+			"name": zoneName,
+			"id":   zoneID,
+		})
+	}
+
+	block["zones"] = zones
+	d.Set("reverse_zone", []interface{}{block})
+
+	return nil
+}
+
 func resourceip6subnetgatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
@@ -391,6 +763,14 @@ func resourceip6subnetgatewayDelete(d *schema.ResourceData, meta interface{}) er
 func resourceip6subnetDelete(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
+	// Deleting the reverse zone(s) if any
+	if blocks := d.Get("reverse_zone").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		if err := resourceip6subnetreversezonedelete(blocks[0].(map[string]interface{})["zones"].([]interface{}), meta); err != nil {
+			// Reporting a failure
+			return err
+		}
+	}
+
 	// Delete related resources such as the Gateway
 	if d.Get("gateway_offset") != 0 {
 		resourceip6subnetgatewayDelete(d, meta)
@@ -453,6 +833,7 @@ func resourceip6subnetRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("block", buf[0]["parent_subnet6_name"].(string))
 			d.Set("name", buf[0]["subnet6_name"].(string))
 			d.Set("class", buf[0]["subnet6_class_name"].(string))
+			subnetvlanset(d, buf[0])
 
 			if buf[0]["is_terminal"].(string) == "1" {
 				d.Set("terminal", true)
@@ -479,7 +860,8 @@ func resourceip6subnetRead(d *schema.ResourceData, meta interface{}) error {
 
 			d.Set("class_parameters", computedClassParameters)
 
-			return nil
+			// Refreshing the reverse zone(s)
+			return resourceip6subnetreversezoneread(d, meta)
 		}
 
 		if len(buf) > 0 {
@@ -505,6 +887,31 @@ func resourceip6subnetRead(d *schema.ResourceData, meta interface{}) error {
 func resourceip6subnetImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (space/address/prefix_size) of the IPv6 subnet if required
+	oid, oidErr := importidresolve(d.Id(), "space/address/prefix_size", "IPv6 subnet", func(keys []string) (string, error) {
+		siteID, siteErr := ipsiteidbyname(keys[0], meta)
+		prefixSize, prefixErr := strconv.Atoi(keys[2])
+		if siteID == "" || prefixErr != nil {
+			return "", siteErr
+		}
+
+		hexAddr := ip6tohexip6(shortip6tolongip6(keys[1]))
+
+		// Looking for a terminal subnet first, then for a block
+		if subnetID, subnetErr := ip6subnetidbyprefix(siteID, hexAddr, prefixSize, true, meta); subnetID != "" || subnetErr != nil {
+			return subnetID, subnetErr
+		}
+
+		return ip6subnetidbyprefix(siteID, hexAddr, prefixSize, false, meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("subnet6_id", d.Id())
@@ -522,6 +929,7 @@ func resourceip6subnetImportState(d *schema.ResourceData, meta interface{}) ([]*
 			d.Set("block", buf[0]["parent_subnet6_name"].(string))
 			d.Set("name", buf[0]["subnet6_name"].(string))
 			d.Set("class", buf[0]["subnet6_class_name"].(string))
+			subnetvlanset(d, buf[0])
 
 			if buf[0]["is_terminal"].(string) == "1" {
 				d.Set("terminal", true)
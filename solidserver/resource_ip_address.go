@@ -8,7 +8,6 @@ import (
 	"log"
 	"net/url"
 	"regexp"
-	"strings"
 )
 
 func resourceipaddress() *schema.Resource {
@@ -21,25 +20,26 @@ func resourceipaddress() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceipaddressImportState,
 		},
+		CustomizeDiff: resourceipaddressCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"space": {
 				Type:        schema.TypeString,
 				Description: "The name of the space into which creating the IP address.",
 				Required:    true,
-				ForceNew:    true,
+				ForceNew:    false,
 			},
 			"subnet": {
 				Type:        schema.TypeString,
 				Description: "The name of the subnet into which creating the IP address.",
 				Required:    true,
-				ForceNew:    true,
+				ForceNew:    false,
 			},
 			"pool": {
 				Type:        schema.TypeString,
 				Description: "The name of the pool into which creating the IP address.",
 				Optional:    true,
-				ForceNew:    true,
+				ForceNew:    false,
 				Default:     "",
 			},
 			"request_ip": {
@@ -47,14 +47,20 @@ func resourceipaddress() *schema.Resource {
 				Description:  "The optionally requested IP address.",
 				ValidateFunc: validation.SingleIP(),
 				Optional:     true,
-				ForceNew:     true,
+				ForceNew:     false,
 				Default:      "",
 			},
 			"address": {
 				Type:        schema.TypeString,
 				Description: "The provisionned IP address.",
 				Computed:    true,
-				ForceNew:    true,
+			},
+			"max_utilization_percent": {
+				Type:         schema.TypeInt,
+				Description:  "The maximum utilization percentage of the subnet above which the IP address can't be allocated (Default: 0, disabled).",
+				ValidateFunc: validation.IntBetween(0, 100),
+				Optional:     true,
+				Default:      0,
 			},
 			"device": {
 				Type:        schema.TypeString,
@@ -78,6 +84,67 @@ func resourceipaddress() *schema.Resource {
 				DiffSuppressFunc: resourcediffsuppresscase,
 				Default:          "",
 			},
+			"dns_server": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS server or DNS SMART hosting the A record of the IP address.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"dns_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view hosting the A record of the IP address.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"dns_zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS zone hosting the A record of the IP address.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"dns_ttl": {
+				Type:        schema.TypeInt,
+				Description: "The DNS Time To Live of the A and PTR records of the IP address.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     3600,
+			},
+			"create_ptr": {
+				Type:        schema.TypeBool,
+				Description: "Create the PTR record of the IP address along with its A record.",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     false,
+			},
+			"release_policy": {
+				Type:         schema.TypeString,
+				Description:  "The policy applied to the IP address on destroy (Supported: delete, quarantine; Default: delete).",
+				ValidateFunc: validation.StringInSlice([]string{"delete", "quarantine"}, false),
+				Optional:     true,
+				Default:      "delete",
+			},
+			"quarantine_period": {
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds the released IP address is kept in quarantine before being allocatable again (Default: 86400).",
+				ValidateFunc: validation.IntAtLeast(1),
+				Optional:     true,
+				Default:      86400,
+			},
+			"quarantine_class": {
+				Type:        schema.TypeString,
+				Description: "The class applied to the IP address while in quarantine (Default: keep its class).",
+				Optional:    true,
+				Default:     "",
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Adopt the existing IP address matching the requested IP instead of failing, reconciling its attributes (Default: false).",
+				Optional:    true,
+				Default:     false,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IP address.",
@@ -136,12 +203,19 @@ func resourceipaddressExists(d *schema.ResourceData, meta interface{}) (bool, er
 	return false, err
 }
 
-func resourceipaddressCreate(d *schema.ResourceData, meta interface{}) error {
-	s := meta.(*SOLIDserver)
+func resourceipaddressCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Checking the requested IP address against its subnet and pool bounds
+	if d.Id() == "" || d.HasChange("space") || d.HasChange("subnet") || d.HasChange("pool") || d.HasChange("request_ip") {
+		if err := ipaddresscheckbounds(d, false, meta); err != nil {
+			return err
+		}
+	}
 
-	var requestedHexIP string = iptohexip(d.Get("request_ip").(string))
-	var poolInfo map[string]interface{} = nil
-	var ipAddresses []string = nil
+	// Planning the new address of the IP address when it is moved
+	return ipaddressplanmove(d, false)
+}
+
+func resourceipaddressCreate(d *schema.ResourceData, meta interface{}) error {
 	var deviceID string = ""
 
 	// Gather required ID(s) from provided information
@@ -152,69 +226,112 @@ func resourceipaddressCreate(d *schema.ResourceData, meta interface{}) error {
 		return siteErr
 	}
 
-	//subnetID, subnetErr := ipsubnetidbyname(siteID, d.Get("subnet").(string), true, meta)
-	//if subnetErr != nil {
-	//	// Reporting a failure
-	//	return subnetErr
-	//}
+	// Adopting the existing IP address if required
+	if adopted, adoptErr := resourceipaddressadopt(d, siteID, meta); adopted || adoptErr != nil {
+		return adoptErr
+	}
 
-	subnetInfo, subnetErr := ipsubnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
+	// Retrieving device ID
+	if len(d.Get("device").(string)) > 0 {
+		var deviceErr error = nil
 
-	if subnetInfo == nil || subnetErr != nil {
-		// Reporting a failure
-		if subnetInfo == nil {
-			return fmt.Errorf("SOLIDServer - Unable to create IP address: %s, unable to find requested network\n", d.Get("name").(string))
+		deviceID, deviceErr = hostdevidbyname(d.Get("device").(string), meta)
+		if deviceErr != nil {
+			// Reporting a failure
+			return deviceErr
 		}
+	}
 
-		return subnetErr
+	// Releasing the quarantined IP addresses of the subnet whose quarantine period is over
+	if releaseErr := ipaddressreleaseexpired(siteID, d.Get("subnet").(string), false, meta); releaseErr != nil {
+		// Reporting a failure
+		return releaseErr
 	}
 
-	if len(d.Get("pool").(string)) > 0 {
-		var poolErr error = nil
+	// Determining if an IP address was submitted in or if we should get one from the IPAM
+	ipAddresses, ipErr := resourceipaddresscandidates(d, siteID, meta)
 
-		poolInfo, poolErr = ippoolinfobyname(siteID, d.Get("pool").(string), d.Get("subnet").(string), meta)
-		if poolErr != nil {
-			// Reporting a failure
-			return poolErr
-		}
+	if ipErr != nil {
+		// Reporting a failure
+		return ipErr
 	}
 
+	oid, address, addErr := ipaddressadd(d, siteID, deviceID, ipAddresses, false, meta)
+
+	if addErr != nil {
+		// Reporting a failure
+		return addErr
+	}
+
+	d.SetId(oid)
+	d.Set("address", address)
+
+	// Registering the DNS records if required
+	return ipaddressdnssync(d, "A", "address", meta)
+}
+
+// Take over the existing IP address matching the requested IP and reconcile its attributes
+// Return false when adoption is disabled or no such IP address exists
+func resourceipaddressadopt(d *schema.ResourceData, siteID string, meta interface{}) (bool, error) {
+	if !d.Get("adopt_existing").(bool) || d.Get("request_ip").(string) == "" {
+		return false, nil
+	}
+
+	addressID, addressErr := ipaddressidbyip(siteID, d.Get("request_ip").(string), meta)
+	if addressID == "" || addressErr != nil {
+		return false, addressErr
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Adopting IP address (oid): %s\n", addressID)
+	d.SetId(addressID)
+	d.Set("address", d.Get("request_ip").(string))
+
+	return true, resourceipaddressUpdate(d, meta)
+}
+
+// Compute the candidate IP addresses of the resource from its subnet, pool and requested IP
+func resourceipaddresscandidates(d *schema.ResourceData, siteID string, meta interface{}) ([]string, error) {
+	return ipaddresscandidates(siteID, d.Get("subnet").(string), d.Get("pool").(string), d.Get("request_ip").(string), d.Get("name").(string), d.Get("max_utilization_percent").(int), meta)
+}
+
+func resourceipaddressUpdate(d *schema.ResourceData, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	var siteID string = ""
+	var ipAddresses []string = []string{""}
+	var deviceID string = ""
+
 	// Retrieving device ID
 	if len(d.Get("device").(string)) > 0 {
-		var deviceErr error = nil
+		var err error = nil
 
-		deviceID, deviceErr = hostdevidbyname(d.Get("device").(string), meta)
-		if deviceErr != nil {
+		deviceID, err = hostdevidbyname(d.Get("device").(string), meta)
+
+		if err != nil {
 			// Reporting a failure
-			return deviceErr
+			return err
 		}
 	}
 
-	// Determining if an IP address was submitted in or if we should get one from the IPAM
-	if len(d.Get("request_ip").(string)) > 0 {
-		// Ensure IP Address is within the given subnet start and end IP addresses
-		if strings.Compare(subnetInfo["terminal"].(string), "1") == 0 &&
-			strings.Compare(subnetInfo["start_hex_addr"].(string), requestedHexIP) == -1 &&
-			strings.Compare(requestedHexIP, subnetInfo["end_hex_addr"].(string)) == -1 {
-
-			if poolInfo != nil && (strings.Compare(poolInfo["start_hex_addr"].(string), requestedHexIP) == 1 ||
-				strings.Compare(requestedHexIP, poolInfo["end_hex_addr"].(string)) == 1) {
-				return fmt.Errorf("SOLIDServer - Unable to create IP address: %s, address is out of pool's range\n", d.Get("name").(string))
-			}
+	// Determining if the IP address must be moved to another space, subnet, pool or address
+	if resourceipaddressmoverequired(d) {
+		var siteErr error = nil
+		var ipErr error = nil
 
-			ipAddresses = []string{d.Get("request_ip").(string)}
-		} else {
-			return fmt.Errorf("SOLIDServer - Unable to create IP address: %s, address is out of network's range\n", d.Get("name").(string))
+		siteID, siteErr = ipsiteidbyname(d.Get("space").(string), meta)
+
+		if siteErr != nil {
+			// Reporting a failure
+			return siteErr
 		}
-	} else {
-		var poolID string = ""
-		var ipErr error = nil
 
-		if poolInfo != nil {
-			poolID = poolInfo["id"].(string)
+		// Releasing the quarantined IP addresses of the subnet whose quarantine period is over
+		if releaseErr := ipaddressreleaseexpired(siteID, d.Get("subnet").(string), false, meta); releaseErr != nil {
+			// Reporting a failure
+			return releaseErr
 		}
 
-		ipAddresses, ipErr = ipaddressfindfree(subnetInfo["id"].(string), poolID, meta)
+		ipAddresses, ipErr = resourceipaddressmovecandidates(d, siteID, meta)
 
 		if ipErr != nil {
 			// Reporting a failure
@@ -225,13 +342,18 @@ func resourceipaddressCreate(d *schema.ResourceData, meta interface{}) error {
 	for i := 0; i < len(ipAddresses); i++ {
 		// Building parameters
 		parameters := url.Values{}
-		parameters.Add("site_id", siteID)
-		parameters.Add("add_flag", "new_only")
+		parameters.Add("ip_id", d.Id())
+		parameters.Add("add_flag", "edit_only")
 		parameters.Add("ip_name", d.Get("name").(string))
-		parameters.Add("hostaddr", ipAddresses[i])
 		parameters.Add("hostdev_id", deviceID)
 		parameters.Add("ip_class_name", d.Get("class").(string))
 
+		// Moving the IP address while keeping the same object
+		if ipAddresses[i] != "" {
+			parameters.Add("site_id", siteID)
+			parameters.Add("hostaddr", ipAddresses[i])
+		}
+
 		if d.Get("mac").(string) != "" {
 			parameters.Add("mac_addr", d.Get("mac").(string))
 		}
@@ -239,8 +361,8 @@ func resourceipaddressCreate(d *schema.ResourceData, meta interface{}) error {
 		// Building class_parameters
 		parameters.Add("ip_class_parameters", urlfromclassparams(d.Get("class_parameters")).Encode())
 
-		// Sending the creation request
-		resp, body, err := s.Request("post", "rest/ip_add", &parameters)
+		// Sending the update request
+		resp, body, err := s.Request("put", "rest/ip_add", &parameters)
 
 		if err == nil {
 			var buf [](map[string]interface{})
@@ -249,123 +371,102 @@ func resourceipaddressCreate(d *schema.ResourceData, meta interface{}) error {
 			// Checking the answer
 			if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
 				if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
-					log.Printf("[DEBUG] SOLIDServer - Created IP address (oid): %s\n", oid)
+					log.Printf("[DEBUG] SOLIDServer - Updated IP address (oid): %s\n", oid)
 					d.SetId(oid)
-					d.Set("address", ipAddresses[i])
-					return nil
-				}
-			} else {
-				if len(buf) > 0 {
-					if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-						log.Printf("[DEBUG] SOLIDServer - Failed IP address registration for IP address: %s with address: %s (%s)\n", d.Get("name").(string), ipAddresses[i], errMsg)
-					} else {
-						log.Printf("[DEBUG] SOLIDServer - Failed IP address registration for IP address: %s with address: %s\n", d.Get("name").(string), ipAddresses[i])
+					if ipAddresses[i] != "" {
+						d.Set("address", ipAddresses[i])
 					}
-				} else {
-					log.Printf("[DEBUG] SOLIDServer - Failed IP address registration for IP address: %s with address: %s\n", d.Get("name").(string), ipAddresses[i])
+
+					// Synchronizing the DNS records if required
+					if d.HasChange("dns_server") || d.HasChange("dns_view") || d.HasChange("dns_zone") || d.HasChange("dns_ttl") ||
+						d.HasChange("create_ptr") || d.HasChange("name") || ipAddresses[i] != "" {
+						return ipaddressdnssync(d, "A", "address", meta)
+					}
+
+					return nil
 				}
 			}
-		} else {
+
+			// Trying the next candidate address in case of a move
+			if ipAddresses[i] != "" && i+1 < len(ipAddresses) {
+				log.Printf("[DEBUG] SOLIDServer - Failed to move IP address: %s to address: %s\n", d.Get("name").(string), ipAddresses[i])
+				continue
+			}
+
 			// Reporting a failure
-			return err
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return fmt.Errorf("SOLIDServer - Unable to update IP address: %s (%s)", d.Get("name").(string), errMsg)
+				}
+			}
+
+			return fmt.Errorf("SOLIDServer - Unable to update IP address: %s\n", d.Get("name").(string))
 		}
+
+		// Reporting a failure
+		return err
 	}
 
 	// Reporting a failure
-	return fmt.Errorf("SOLIDServer - Unable to create IP address: %s, unable to find a suitable network or address\n", d.Get("name").(string))
+	return fmt.Errorf("SOLIDServer - Unable to update IP address: %s, unable to find a suitable network or address\n", d.Get("name").(string))
 }
 
-func resourceipaddressUpdate(d *schema.ResourceData, meta interface{}) error {
-	s := meta.(*SOLIDserver)
-
-	var deviceID string = ""
+// Compute the candidate IP addresses of a move, keeping the current address when it falls within the new subnet and pool
+func resourceipaddressmovecandidates(d *schema.ResourceData, siteID string, meta interface{}) ([]string, error) {
+	if d.Get("request_ip").(string) == "" {
+		oldAddress, _ := d.GetChange("address")
 
-	// Retrieving device ID
-	if len(d.Get("device").(string)) > 0 {
-		var err error = nil
+		if _, err := ipaddresscandidates(siteID, d.Get("subnet").(string), d.Get("pool").(string), oldAddress.(string), d.Get("name").(string), 0, meta); err == nil {
+			ipAddresses := []string{oldAddress.(string)}
 
-		deviceID, err = hostdevidbyname(d.Get("device").(string), meta)
+			// The current address may already be used within the new space, falling back on the other candidates
+			if d.HasChange("space") {
+				if candidates, candidatesErr := resourceipaddresscandidates(d, siteID, meta); candidatesErr == nil {
+					ipAddresses = append(ipAddresses, candidates...)
+				}
+			}
 
-		if err != nil {
-			// Reporting a failure
-			return err
+			return ipAddresses, nil
 		}
 	}
 
-	// Building parameters
-	parameters := url.Values{}
-	parameters.Add("ip_id", d.Id())
-	parameters.Add("add_flag", "edit_only")
-	parameters.Add("ip_name", d.Get("name").(string))
-	parameters.Add("hostdev_id", deviceID)
-	parameters.Add("ip_class_name", d.Get("class").(string))
+	return resourceipaddresscandidates(d, siteID, meta)
+}
 
-	if d.Get("mac").(string) != "" {
-		parameters.Add("mac_addr", d.Get("mac").(string))
+// Determine if the space, subnet, pool or requested IP changes require the IP address to be moved
+func resourceipaddressmoverequired(d *schema.ResourceData) bool {
+	// An adopted IP address stays where it is
+	if d.IsNewResource() {
+		return false
 	}
 
-	// Building class_parameters
-	parameters.Add("ip_class_parameters", urlfromclassparams(d.Get("class_parameters")).Encode())
-
-	// Sending the update request
-	resp, body, err := s.Request("put", "rest/ip_add", &parameters)
-
-	if err == nil {
-		var buf [](map[string]interface{})
-		json.Unmarshal([]byte(body), &buf)
-
-		// Checking the answer
-		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
-			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
-				log.Printf("[DEBUG] SOLIDServer - Updated IP address (oid): %s\n", oid)
-				d.SetId(oid)
-				return nil
-			}
-		}
-
-		// Reporting a failure
-		if len(buf) > 0 {
-			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-				return fmt.Errorf("SOLIDServer - Unable to update IP address: %s (%s)", d.Get("name").(string), errMsg)
-			}
-		}
+	if d.HasChange("space") || d.HasChange("subnet") || d.HasChange("pool") {
+		return true
+	}
 
-		return fmt.Errorf("SOLIDServer - Unable to update IP address: %s\n", d.Get("name").(string))
+	// Dropping the requested IP keeps the current address
+	if d.HasChange("request_ip") && d.Get("request_ip").(string) != "" {
+		oldAddress, _ := d.GetChange("address")
+		return iptohexip(d.Get("request_ip").(string)) != iptohexip(oldAddress.(string))
 	}
 
-	// Reporting a failure
-	return err
+	return false
 }
 
 func resourceipaddressDelete(d *schema.ResourceData, meta interface{}) error {
-	s := meta.(*SOLIDserver)
-
-	// Building parameters
-	parameters := url.Values{}
-	parameters.Add("ip_id", d.Id())
-
-	// Sending the deletion request
-	resp, body, err := s.Request("delete", "rest/ip_delete", &parameters)
-
-	if err == nil {
-		var buf [](map[string]interface{})
-		json.Unmarshal([]byte(body), &buf)
+	// Deleting the DNS records if any
+	if err := ipaddressdnsdelete(d, "A", "address", meta); err != nil {
+		// Reporting a failure
+		return err
+	}
 
-		// Checking the answer
-		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+	// Keeping the IP address registered in quarantine if required
+	if d.Get("release_policy").(string) == "quarantine" {
+		if err := ipaddressquarantine(d, false, meta); err != nil {
 			// Reporting a failure
-			if len(buf) > 0 {
-				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-					return fmt.Errorf("SOLIDServer - Unable to delete IP address : %s (%s)", d.Get("name").(string), errMsg)
-				}
-			}
-
-			return fmt.Errorf("SOLIDServer - Unable to delete IP address : %s", d.Get("name").(string))
+			return err
 		}
 
-		// Log deletion
-		log.Printf("[DEBUG] SOLIDServer - Deleted IP address's oid: %s\n", d.Id())
-
 		// Unset local ID
 		d.SetId("")
 
@@ -373,8 +474,16 @@ func resourceipaddressDelete(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	// Reporting a failure
-	return err
+	if err := ipaddressdelete(d.Id(), false, meta); err != nil {
+		// Reporting a failure
+		return err
+	}
+
+	// Unset local ID
+	d.SetId("")
+
+	// Reporting a success
+	return nil
 }
 
 func resourceipaddressRead(d *schema.ResourceData, meta interface{}) error {
@@ -447,6 +556,23 @@ func resourceipaddressRead(d *schema.ResourceData, meta interface{}) error {
 func resourceipaddressImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (space/address) of the IP address if required
+	oid, oidErr := importidresolve(d.Id(), "space/address", "IP address", func(keys []string) (string, error) {
+		siteID, siteErr := ipsiteidbyname(keys[0], meta)
+		if siteID == "" {
+			return "", siteErr
+		}
+
+		return ipaddressidbyip(siteID, keys[1], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("ip_id", d.Id())
@@ -21,6 +21,7 @@ func resourceippool() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceippoolImportState,
 		},
+		CustomizeDiff: resourceippoolCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"space": {
@@ -71,6 +72,12 @@ func resourceippool() *schema.Resource {
 				Description: "The size prefix of the parent subnet of the pool.",
 				Computed:    true,
 			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Adopt the existing IP pool matching the start and size instead of failing, reconciling its attributes (Default: false).",
+				Optional:    true,
+				Default:     false,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IP pool.",
@@ -129,6 +136,58 @@ func resourceippoolExists(d *schema.ResourceData, meta interface{}) (bool, error
 	return false, err
 }
 
+func resourceippoolCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("space") && !d.HasChange("subnet") && !d.HasChange("start") && !d.HasChange("size") {
+		return nil
+	}
+
+	for _, key := range []string{"space", "subnet", "start", "size"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	// Parents that can't be resolved yet (ex: created within the same apply) are not checked
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteID == "" || siteErr != nil {
+		return nil
+	}
+
+	subnetInfo, _ := ipsubnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
+	if subnetInfo == nil {
+		return nil
+	}
+
+	startAddr := uint64(iptolong(d.Get("start").(string)))
+	endAddr := startAddr + uint64(d.Get("size").(int)) - 1
+
+	if d.Get("size").(int) < 1 || endAddr > 0xffffffff {
+		return fmt.Errorf("SOLIDServer - IP pool: %s has an invalid size", d.Get("name").(string))
+	}
+
+	startHexAddr := iptohexip(d.Get("start").(string))
+	endHexAddr := iptohexip(longtoip(uint32(endAddr)))
+
+	// Checking the IP pool against its subnet bounds
+	if startHexAddr < subnetInfo["start_hex_addr"].(string) || endHexAddr > subnetInfo["end_hex_addr"].(string) {
+		return fmt.Errorf("SOLIDServer - IP pool: %s is out of subnet: %s range", d.Get("name").(string), d.Get("subnet").(string))
+	}
+
+	// A pool to adopt doesn't overlap itself
+	excludeID := d.Id()
+
+	if excludeID == "" && d.Get("adopt_existing").(bool) {
+		excludeID, _ = ippoolidbyrange(subnetInfo["id"].(string), startHexAddr, endHexAddr, meta)
+	}
+
+	// Checking the IP pool against the other pools of the subnet
+	if overlap, _ := ippooloverlap(subnetInfo["id"].(string), startHexAddr, endHexAddr, excludeID, meta); overlap != "" {
+		return fmt.Errorf("SOLIDServer - IP pool: %s overlaps IP pool: %s", d.Get("name").(string), overlap)
+	}
+
+	return nil
+}
+
 func resourceippoolCreate(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
@@ -146,6 +205,11 @@ func resourceippoolCreate(d *schema.ResourceData, meta interface{}) error {
 		return subnetErr
 	}
 
+	// Adopting the existing IP pool if required
+	if adopted, adoptErr := resourceippooladopt(d, subnetInfo, meta); adopted || adoptErr != nil {
+		return adoptErr
+	}
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("add_flag", "new_only")
@@ -207,6 +271,29 @@ func resourceippoolCreate(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
+// Take over the existing IP pool matching the start and size and reconcile its attributes
+// Return false when adoption is disabled or no such IP pool exists
+func resourceippooladopt(d *schema.ResourceData, subnetInfo map[string]interface{}, meta interface{}) (bool, error) {
+	if !d.Get("adopt_existing").(bool) {
+		return false, nil
+	}
+
+	startHexAddr := iptohexip(d.Get("start").(string))
+	endHexAddr := iptohexip(longtoip(iptolong(d.Get("start").(string)) + uint32(d.Get("size").(int)) - 1))
+
+	poolID, poolErr := ippoolidbyrange(subnetInfo["id"].(string), startHexAddr, endHexAddr, meta)
+	if poolID == "" || poolErr != nil {
+		return false, poolErr
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Adopting IP pool (oid): %s\n", poolID)
+	d.SetId(poolID)
+	d.Set("prefix", subnetInfo["start_addr"].(string)+"/"+strconv.Itoa(subnetInfo["prefix_length"].(int)))
+	d.Set("prefix_size", subnetInfo["prefix_length"].(int))
+
+	return true, resourceippoolUpdate(d, meta)
+}
+
 func resourceippoolUpdate(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
@@ -373,6 +460,23 @@ func resourceippoolRead(d *schema.ResourceData, meta interface{}) error {
 func resourceippoolImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (space/subnet/name) of the IP pool if required
+	oid, oidErr := importidresolve(d.Id(), "space/subnet/name", "IP pool", func(keys []string) (string, error) {
+		siteID, siteErr := ipsiteidbyname(keys[0], meta)
+		if siteID == "" {
+			return "", siteErr
+		}
+
+		return ippoolidbyname(siteID, keys[2], keys[1], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("pool_id", d.Id())
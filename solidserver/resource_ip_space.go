@@ -26,6 +26,13 @@ func resourceipspace() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"parent_space": {
+				Type:        schema.TypeString,
+				Description: "The name of the parent IP space of the IP space (VLSM).",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IP space.",
@@ -90,6 +97,11 @@ func resourceipspaceCreate(d *schema.ResourceData, meta interface{}) error {
 	parameters := url.Values{}
 	parameters.Add("add_flag", "new_only")
 	parameters.Add("site_name", d.Get("name").(string))
+
+	if d.Get("parent_space").(string) != "" {
+		parameters.Add("parent_site_name", d.Get("parent_space").(string))
+	}
+
 	parameters.Add("site_class_name", d.Get("class").(string))
 	parameters.Add("site_class_parameters", urlfromclassparams(d.Get("class_parameters")).Encode())
 
@@ -223,6 +235,10 @@ func resourceipspaceRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("name", buf[0]["site_name"].(string))
 			d.Set("class", buf[0]["site_class_name"].(string))
 
+			if parentSiteName, parentSiteNameExist := buf[0]["parent_site_name"].(string); parentSiteNameExist {
+				d.Set("parent_space", parentSiteName)
+			}
+
 			// Updating local class_parameters
 			currentClassParameters := d.Get("class_parameters").(map[string]interface{})
 			retrievedClassParameters, _ := url.ParseQuery(buf[0]["site_class_parameters"].(string))
@@ -264,6 +280,18 @@ func resourceipspaceRead(d *schema.ResourceData, meta interface{}) error {
 func resourceipspaceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (name) of the IP space if required
+	oid, oidErr := importidresolve(d.Id(), "name", "IP space", func(keys []string) (string, error) {
+		return ipsiteidbyname(keys[0], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("site_id", d.Id())
@@ -280,6 +308,10 @@ func resourceipspaceImportState(d *schema.ResourceData, meta interface{}) ([]*sc
 			d.Set("name", buf[0]["site_name"].(string))
 			d.Set("class", buf[0]["site_class_name"].(string))
 
+			if parentSiteName, parentSiteNameExist := buf[0]["parent_site_name"].(string); parentSiteNameExist {
+				d.Set("parent_space", parentSiteName)
+			}
+
 			// Updating local class_parameters
 			currentClassParameters := d.Get("class_parameters").(map[string]interface{})
 			retrievedClassParameters, _ := url.ParseQuery(buf[0]["site_class_parameters"].(string))
@@ -22,6 +22,7 @@ func resourceipsubnet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceipsubnetImportState,
 		},
+		CustomizeDiff: resourceipsubnetCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"space": {
@@ -37,6 +38,13 @@ func resourceipsubnet() *schema.Resource {
 				ForceNew:    true,
 				Default:     "",
 			},
+			"vlsm_subnet": {
+				Type:        schema.TypeString,
+				Description: "The name of the IP subnet of the parent space into which allocating the IP subnet (VLSM).",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
 			"request_ip": {
 				Type:         schema.TypeString,
 				Description:  "The optionally requested subnet IP address.",
@@ -49,7 +57,16 @@ func resourceipsubnet() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "The expected IP subnet's prefix length (ex: 24 for a '/24').",
 				Required:    true,
-				ForceNew:    true,
+				ForceNew:    false,
+			},
+			"merge_siblings": {
+				Type:        schema.TypeList,
+				Description: "The names of the empty terminal sibling IP subnets the IP subnet may absorb when growing, they must not be managed by another resource.",
+				Optional:    true,
+				ForceNew:    false,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"prefix": {
 				Type:        schema.TypeString,
@@ -60,13 +77,11 @@ func resourceipsubnet() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The provisionned IP network address.",
 				Computed:    true,
-				ForceNew:    true,
 			},
 			"netmask": {
 				Type:        schema.TypeString,
 				Description: "The provisionned IP address netmask.",
 				Computed:    true,
-				ForceNew:    true,
 			},
 			"gateway_offset": {
 				Type:        schema.TypeInt,
@@ -79,7 +94,6 @@ func resourceipsubnet() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The subnet's computed gateway.",
 				Computed:    true,
-				ForceNew:    true,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -94,6 +108,33 @@ func resourceipsubnet() *schema.Resource {
 				ForceNew:    true,
 				Default:     true,
 			},
+			"vlan_domain": {
+				Type:        schema.TypeString,
+				Description: "The optional vlan domain's name of the vlan to associate with the IP subnet (terminal subnet only).",
+				Optional:    true,
+				ForceNew:    false,
+				Default:     "",
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Description: "The optional vlan ID to associate with the IP subnet.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    false,
+			},
+			"vlan_name": {
+				Type:        schema.TypeString,
+				Description: "The optional vlan name to associate with the IP subnet.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    false,
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Adopt the existing IP subnet matching the requested IP and prefix size instead of failing, reconciling its attributes (Default: false).",
+				Optional:    true,
+				Default:     false,
+			},
 			"class": {
 				Type:        schema.TypeString,
 				Description: "The class associated to the IP subnet.",
@@ -155,6 +196,7 @@ func resourceipsubnetExists(d *schema.ResourceData, meta interface{}) (bool, err
 func resourceipsubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	blockInfo := make(map[string]interface{})
 	s := meta.(*SOLIDserver)
+	var vlsmInfo map[string]interface{} = nil
 	var gateway string = ""
 
 	// Gather required ID(s) from provided information
@@ -164,6 +206,11 @@ func resourceipsubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		return siteErr
 	}
 
+	// Adopting the existing IP subnet if required
+	if adopted, adoptErr := resourceipsubnetadopt(d, siteID, meta); adopted || adoptErr != nil {
+		return adoptErr
+	}
+
 	// If a block is specified, look for free IP subnet within this block
 	if len(d.Get("block").(string)) > 0 {
 		var blockErr error = nil
@@ -185,13 +232,54 @@ func resourceipsubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	subnetAddresses, subnetErr := ipsubnetfindbysize(siteID, blockInfo["id"].(string), d.Get("request_ip").(string), d.Get("prefix_size").(int), meta)
+	subnetSiteID := siteID
+	subnetBlockID := blockInfo["id"].(string)
+
+	// If a VLSM subnet is specified, look for free IP subnet within this subnet of the parent space
+	if len(d.Get("vlsm_subnet").(string)) > 0 {
+		var vlsmErr error = nil
+
+		vlsmInfo, vlsmErr = resourceipsubnetvlsminfo(d, siteID, meta)
+
+		if vlsmErr != nil {
+			// Reporting a failure
+			return vlsmErr
+		}
+
+		subnetSiteID = vlsmInfo["site_id"].(string)
+		subnetBlockID = vlsmInfo["id"].(string)
+	}
+
+	subnetAddresses, subnetErr := ipsubnetfindbysize(subnetSiteID, subnetBlockID, d.Get("request_ip").(string), d.Get("prefix_size").(int), meta)
 
 	if subnetErr != nil {
 		// Reporting a failure
 		return subnetErr
 	}
 
+	// Keeping only the addresses also free within the block when both the block and the VLSM subnet are specified
+	if vlsmInfo != nil && blockInfo["id"].(string) != "" {
+		blockAddresses, blockErr := ipsubnetfindbysize(siteID, blockInfo["id"].(string), d.Get("request_ip").(string), d.Get("prefix_size").(int), meta)
+
+		if blockErr != nil {
+			// Reporting a failure
+			return blockErr
+		}
+
+		vlsmAddresses := subnetAddresses
+		subnetAddresses = []string{}
+
+		for _, vlsmAddress := range vlsmAddresses {
+			if stringOffsetInSlice(vlsmAddress, blockAddresses) != -1 {
+				subnetAddresses = append(subnetAddresses, vlsmAddress)
+			}
+		}
+
+		if len(subnetAddresses) == 0 {
+			return fmt.Errorf("SOLIDServer - Unable to find a free IP subnet within both block: %s and VLSM subnet: %s\n", d.Get("block").(string), d.Get("vlsm_subnet").(string))
+		}
+	}
+
 	for i := 0; i < len(subnetAddresses); i++ {
 		// Building parameters
 		parameters := url.Values{}
@@ -217,6 +305,19 @@ func resourceipsubnetCreate(d *schema.ResourceData, meta interface{}) error {
 			parameters.Add("is_terminal", "0")
 		}
 
+		// Link the IP subnet to its VLSM subnet of the parent space
+		if vlsmInfo != nil {
+			parameters.Add("vlsm_subnet_id", vlsmInfo["id"].(string))
+		}
+
+		// Associating the IP subnet with a vlan if required
+		if d.Get("vlan_domain").(string) != "" {
+			if vlanErr := subnetvlanparameters(d, &parameters); vlanErr != nil {
+				// Reporting a failure
+				return vlanErr
+			}
+		}
+
 		// Building class_parameters
 		classParameters := url.Values{}
 
@@ -224,11 +325,7 @@ func resourceipsubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		goffset := d.Get("gateway_offset").(int)
 
 		if goffset != 0 {
-			if goffset > 0 {
-				gateway = longtoip(iptolong(hexiptoip(subnetAddresses[i])) + uint32(goffset))
-			} else {
-				gateway = longtoip(iptolong(hexiptoip(subnetAddresses[i])) + uint32(prefixlengthtosize(d.Get("prefix_size").(int))) - uint32(abs(goffset)) - 1)
-			}
+			gateway = ipsubnetgateway(subnetAddresses[i], d.Get("prefix_size").(int), goffset)
 
 			classParameters.Add("gateway", gateway)
 			log.Printf("[DEBUG] SOLIDServer - Subnet computed gateway: %s\n", gateway)
@@ -286,9 +383,362 @@ func resourceipsubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	return fmt.Errorf("SOLIDServer - Unable to create IP subnet: %s, unable to find a suitable prefix\n", d.Get("name").(string))
 }
 
+// Take over the existing IP subnet matching the requested IP and prefix size
+func resourceipsubnetadopt(d *schema.ResourceData, siteID string, meta interface{}) (bool, error) {
+	if !d.Get("adopt_existing").(bool) || d.Get("request_ip").(string) == "" {
+		return false, nil
+	}
+
+	startHexAddr := iptohexip(d.Get("request_ip").(string))
+
+	subnetID, subnetErr := ipsubnetidbyprefix(siteID, startHexAddr, d.Get("prefix_size").(int), d.Get("terminal").(bool), meta)
+	if subnetID == "" || subnetErr != nil {
+		return false, subnetErr
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Adopting IP subnet (oid): %s\n", subnetID)
+	d.SetId(subnetID)
+	d.Set("prefix", hexiptoip(startHexAddr)+"/"+strconv.Itoa(d.Get("prefix_size").(int)))
+	d.Set("address", hexiptoip(startHexAddr))
+	d.Set("netmask", prefixlengthtohexip(d.Get("prefix_size").(int)))
+
+	if goffset := d.Get("gateway_offset").(int); goffset != 0 {
+		d.Set("gateway", ipsubnetgateway(startHexAddr, d.Get("prefix_size").(int), goffset))
+	}
+
+	return true, resourceipsubnetUpdate(d, meta)
+}
+
+// Return a map of information about the VLSM subnet of the parent space of the IP subnet
+// Or an error if the space has no parent or the VLSM subnet can't be found
+func resourceipsubnetvlsminfo(d *schema.ResourceData, siteID string, meta interface{}) (map[string]interface{}, error) {
+	parentSiteID, parentSiteErr := ipsiteparentidbyid(siteID, meta)
+
+	if parentSiteErr != nil {
+		// Reporting a failure
+		return nil, parentSiteErr
+	}
+
+	if parentSiteID == "" {
+		return nil, fmt.Errorf("SOLIDServer - Unable to create IP subnet: %s, space: %s has no parent space\n", d.Get("name").(string), d.Get("space").(string))
+	}
+
+	vlsmInfo, vlsmErr := ipsubnetinfobyname(parentSiteID, d.Get("vlsm_subnet").(string), false, meta)
+
+	if vlsmErr != nil {
+		// Reporting a failure
+		return nil, vlsmErr
+	}
+
+	vlsmInfo["site_id"] = parentSiteID
+
+	return vlsmInfo, nil
+}
+
+// Update the local vlsm_subnet from the VLSM subnet ID of the IP subnet information
+func resourceipsubnetvlsmset(d *schema.ResourceData, subnetInfo map[string]interface{}, meta interface{}) error {
+	vlsmSubnetID, vlsmSubnetIDExist := subnetInfo["vlsm_subnet_id"].(string)
+
+	if !vlsmSubnetIDExist {
+		return nil
+	}
+
+	if vlsmSubnetID == "" || vlsmSubnetID == "0" {
+		d.Set("vlsm_subnet", "")
+		return nil
+	}
+
+	vlsmName, vlsmErr := ipsubnetnamebyid(vlsmSubnetID, meta)
+
+	if vlsmName == "" {
+		// Reporting a failure
+		if vlsmErr != nil {
+			return vlsmErr
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to find the VLSM subnet (oid): %s of IP subnet: %s\n", vlsmSubnetID, d.Get("name").(string))
+	}
+
+	d.Set("vlsm_subnet", vlsmName)
+
+	return nil
+}
+
+func resourceipsubnetCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Resizing the IP subnet changes its computed network address, prefix and netmask
+	if d.Id() != "" && d.HasChange("prefix_size") {
+		d.SetNewComputed("address")
+		d.SetNewComputed("prefix")
+		d.SetNewComputed("netmask")
+
+		// The gateway follows the new boundaries of the IP subnet
+		if d.Get("gateway_offset").(int) != 0 {
+			d.SetNewComputed("gateway")
+		}
+	}
+
+	if !d.NewValueKnown("prefix_size") || !d.NewValueKnown("gateway_offset") {
+		return nil
+	}
+
+	size := prefixlengthtosize(d.Get("prefix_size").(int))
+	if size < 1 {
+		return nil
+	}
+
+	// Checking the gateway offset against the subnet size, excluding network and broadcast addresses
+	if goffset := d.Get("gateway_offset").(int); goffset != 0 && abs(goffset) > size-2 {
+		return fmt.Errorf("SOLIDServer - Gateway offset: %d is out of IP subnet: %s range", goffset, d.Get("name").(string))
+	}
+
+	// Checking the requested IP subnet address against its parent block bounds
+	if d.Id() != "" && !d.HasChange("space") && !d.HasChange("block") && !d.HasChange("request_ip") {
+		return nil
+	}
+
+	for _, key := range []string{"space", "block", "request_ip"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	if d.Get("request_ip").(string) == "" {
+		return nil
+	}
+
+	startAddr := uint64(iptolong(d.Get("request_ip").(string)))
+	endAddr := startAddr + uint64(size) - 1
+
+	if startAddr%uint64(size) != 0 {
+		return fmt.Errorf("SOLIDServer - Requested address: %s is not aligned on a /%d boundary", d.Get("request_ip").(string), d.Get("prefix_size").(int))
+	}
+
+	if d.Get("block").(string) == "" {
+		return nil
+	}
+
+	// Parents that can't be resolved yet (ex: created within the same apply) are not checked
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteID == "" || siteErr != nil {
+		return nil
+	}
+
+	blockInfo, _ := ipsubnetinfobyname(siteID, d.Get("block").(string), false, meta)
+	if blockInfo == nil {
+		return nil
+	}
+
+	if iptohexip(d.Get("request_ip").(string)) < blockInfo["start_hex_addr"].(string) || endAddr > 0xffffffff ||
+		iptohexip(longtoip(uint32(endAddr))) > blockInfo["end_hex_addr"].(string) {
+		return fmt.Errorf("SOLIDServer - Requested address: %s/%d is out of block: %s range", d.Get("request_ip").(string), d.Get("prefix_size").(int), d.Get("block").(string))
+	}
+
+	return nil
+}
+
+// Check that an IP subnet can be resized in place
+// Return its new network address and the empty siblings to merge, or an error if the resize is not possible
+func resourceipsubnetresize(d *schema.ResourceData, meta interface{}) (string, []map[string]interface{}, error) {
+	var parentID string = ""
+
+	oldPrefixSize, newPrefixSize := d.GetChange("prefix_size")
+
+	// The network address is marked as new computed by the resize, relying on the current one
+	oldAddress, _ := d.GetChange("address")
+
+	// Computing the new bounds of the IP subnet
+	size := uint32(prefixlengthtosize(newPrefixSize.(int)))
+	startAddr := iptolong(oldAddress.(string)) & ^(size - 1)
+	startHexAddr := iptohexip(longtoip(startAddr))
+	endHexAddr := iptohexip(longtoip(startAddr + size - 1))
+
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+
+	if siteErr != nil {
+		// Reporting a failure
+		return "", nil, siteErr
+	}
+
+	// Ensure the new bounds remain within the parent block
+	if len(d.Get("block").(string)) > 0 {
+		blockInfo, blockErr := ipsubnetinfobyname(siteID, d.Get("block").(string), false, meta)
+
+		if blockErr != nil {
+			// Reporting a failure
+			return "", nil, blockErr
+		}
+
+		if startHexAddr < blockInfo["start_hex_addr"].(string) || blockInfo["end_hex_addr"].(string) < endHexAddr {
+			return "", nil, fmt.Errorf("SOLIDServer - Unable to resize IP subnet: %s, new range is out of block's range\n", d.Get("name").(string))
+		}
+
+		parentID = blockInfo["id"].(string)
+	}
+
+	if newPrefixSize.(int) > oldPrefixSize.(int) {
+		// Shrinking, existing addresses or child subnets must remain within the new bounds
+		count := 0
+
+		if d.Get("terminal").(bool) {
+			count = ipaddresscountoutofrange(d.Id(), startHexAddr, endHexAddr, meta)
+		} else {
+			count = ipsubnetcountoutofrange(d.Id(), startHexAddr, endHexAddr, meta)
+		}
+
+		if count < 0 {
+			return "", nil, fmt.Errorf("SOLIDServer - Unable to resize IP subnet: %s, unable to check its content\n", d.Get("name").(string))
+		}
+
+		if count > 0 {
+			return "", nil, fmt.Errorf("SOLIDServer - Unable to resize IP subnet: %s, %d object(s) would fall outside of the new range\n", d.Get("name").(string), count)
+		}
+
+		return longtoip(startAddr), nil, nil
+	}
+
+	// Growing, the neighboring space must be free or made of empty siblings explicitly listed for merge
+	siblings, siblingsErr := ipsubnetsiblingsinrange(siteID, d.Id(), parentID, startHexAddr, endHexAddr, meta)
+
+	if siblingsErr != nil {
+		// Reporting a failure
+		return "", nil, siblingsErr
+	}
+
+	mergeable := toStringArray(d.Get("merge_siblings").([]interface{}))
+
+	for _, sibling := range siblings {
+		siblingName, _ := sibling["subnet_name"].(string)
+
+		if stringOffsetInSlice(siblingName, mergeable) < 0 {
+			return "", nil, fmt.Errorf("SOLIDServer - Unable to resize IP subnet: %s, new range overlaps IP subnet: %s\n", d.Get("name").(string), siblingName)
+		}
+
+		if usedSize, usedSizeExist := sibling["subnet_ip_used_size"].(string); sibling["is_terminal"] != "1" || !usedSizeExist || usedSize != "0" {
+			return "", nil, fmt.Errorf("SOLIDServer - Unable to merge IP subnet: %s into IP subnet: %s, it is not an empty terminal subnet\n", siblingName, d.Get("name").(string))
+		}
+	}
+
+	return longtoip(startAddr), siblings, nil
+}
+
+// Delete the sibling IP subnets absorbed by the IP subnet
+// Return the deleted siblings, to be restored in case of failure, along with the error if any
+func resourceipsubnetmerge(d *schema.ResourceData, siblings []map[string]interface{}, meta interface{}) ([]map[string]interface{}, error) {
+	s := meta.(*SOLIDserver)
+	merged := []map[string]interface{}{}
+
+	for _, sibling := range siblings {
+		// Building parameters
+		parameters := url.Values{}
+		parameters.Add("subnet_id", sibling["subnet_id"].(string))
+
+		// Sending the deletion request
+		resp, body, err := s.Request("delete", "rest/ip_subnet_delete", &parameters)
+
+		if err != nil {
+			// Reporting a failure
+			return merged, err
+		}
+
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			var buf [](map[string]interface{})
+			json.Unmarshal([]byte(body), &buf)
+
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return merged, fmt.Errorf("SOLIDServer - Unable to merge IP subnet: %s (%s)", sibling["subnet_name"].(string), errMsg)
+				}
+			}
+
+			return merged, fmt.Errorf("SOLIDServer - Unable to merge IP subnet: %s", sibling["subnet_name"].(string))
+		}
+
+		log.Printf("[DEBUG] SOLIDServer - Merged IP subnet (oid): %s into IP subnet (oid): %s\n", sibling["subnet_id"].(string), d.Id())
+		merged = append(merged, sibling)
+	}
+
+	return merged, nil
+}
+
+// Recreate the sibling IP subnets deleted by a merge that couldn't be completed
+func resourceipsubnetmergerollback(d *schema.ResourceData, siblings []map[string]interface{}, meta interface{}) {
+	s := meta.(*SOLIDserver)
+
+	if len(siblings) == 0 {
+		return
+	}
+
+	siteID, _ := ipsiteidbyname(d.Get("space").(string), meta)
+
+	for _, sibling := range siblings {
+		size, _ := sibling["subnet_size"].(string)
+		startHexAddr, _ := sibling["start_ip_addr"].(string)
+		level, _ := sibling["subnet_level"].(string)
+		subnetSize, _ := strconv.Atoi(size)
+
+		// Building parameters
+		parameters := url.Values{}
+		parameters.Add("site_id", siteID)
+		parameters.Add("add_flag", "new_only")
+		parameters.Add("subnet_name", sibling["subnet_name"].(string))
+		parameters.Add("subnet_addr", hexiptoip(startHexAddr))
+		parameters.Add("subnet_prefix", strconv.Itoa(sizetoprefixlength(subnetSize)))
+		parameters.Add("subnet_level", level)
+		parameters.Add("is_terminal", "1")
+
+		if className, classNameExist := sibling["subnet_class_name"].(string); classNameExist {
+			parameters.Add("subnet_class_name", className)
+		}
+
+		if classParameters, classParametersExist := sibling["subnet_class_parameters"].(string); classParametersExist {
+			parameters.Add("subnet_class_parameters", classParameters)
+		}
+
+		// Sending the creation request
+		resp, _, err := s.Request("post", "rest/ip_subnet_add", &parameters)
+
+		if err != nil || (resp.StatusCode != 200 && resp.StatusCode != 201) {
+			log.Printf("[DEBUG] SOLIDServer - Unable to restore merged IP subnet: %s\n", sibling["subnet_name"].(string))
+			continue
+		}
+
+		log.Printf("[DEBUG] SOLIDServer - Restored merged IP subnet: %s\n", sibling["subnet_name"].(string))
+	}
+}
+
 func resourceipsubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
+	var address string = ""
+	var merged []map[string]interface{} = nil
+
+	// Resizing the IP subnet if required, an adopted one keeps its size
+	if d.HasChange("prefix_size") && !d.IsNewResource() {
+		var siblings []map[string]interface{} = nil
+		var resizeErr error = nil
+
+		address, siblings, resizeErr = resourceipsubnetresize(d, meta)
+
+		if resizeErr != nil {
+			// Reporting a failure
+			return resizeErr
+		}
+
+		// Overlapping subnets are refused, the merged siblings are deleted right before the resize and restored if it fails
+		if len(siblings) > 0 {
+			var mergeErr error = nil
+
+			merged, mergeErr = resourceipsubnetmerge(d, siblings, meta)
+
+			if mergeErr != nil {
+				resourceipsubnetmergerollback(d, merged, meta)
+
+				// Reporting a failure
+				return mergeErr
+			}
+		}
+	}
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("subnet_id", d.Id())
@@ -296,21 +746,45 @@ func resourceipsubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 	parameters.Add("subnet_name", d.Get("name").(string))
 	parameters.Add("subnet_class_name", d.Get("class").(string))
 
+	if address != "" {
+		parameters.Add("subnet_addr", address)
+		parameters.Add("subnet_prefix", strconv.Itoa(d.Get("prefix_size").(int)))
+	}
+
 	if d.Get("terminal").(bool) {
 		parameters.Add("is_terminal", "1")
 	} else {
 		parameters.Add("is_terminal", "0")
 	}
 
+	// Updating the vlan association if required
+	if d.HasChange("vlan_domain") || d.HasChange("vlan_id") || d.HasChange("vlan_name") {
+		if vlanErr := subnetvlanparameters(d, &parameters); vlanErr != nil {
+			// Reporting a failure
+			return vlanErr
+		}
+	}
+
 	// Building class_parameters
 	classParameters := url.Values{}
 
 	// Generate class parameter for the gateway if required
 	goffset := d.Get("gateway_offset").(int)
+	gateway := d.Get("gateway").(string)
 
 	if goffset != 0 {
-		classParameters.Add("gateway", d.Get("gateway").(string))
-		log.Printf("[DEBUG] SOLIDServer - Subnet updated gateway: %s\n", d.Get("gateway").(string))
+		// Recomputing the gateway of a resized IP subnet, the previous one is released as it may lie outside of it
+		if address != "" {
+			oldGateway, _ := d.GetChange("gateway")
+			gateway = ipsubnetgateway(iptohexip(address), d.Get("prefix_size").(int), goffset)
+
+			if oldGateway.(string) != "" && oldGateway.(string) != gateway {
+				resourceipsubnetgatewayDelete(d, oldGateway.(string), meta)
+			}
+		}
+
+		classParameters.Add("gateway", gateway)
+		log.Printf("[DEBUG] SOLIDServer - Subnet updated gateway: %s\n", gateway)
 	}
 
 	for k, v := range d.Get("class_parameters").(map[string]interface{}) {
@@ -330,10 +804,21 @@ func resourceipsubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
 				log.Printf("[DEBUG] SOLIDServer - Updated IP subnet (oid): %s\n", oid)
 				d.SetId(oid)
+				if address != "" {
+					d.Set("prefix", address+"/"+strconv.Itoa(d.Get("prefix_size").(int)))
+					d.Set("address", address)
+					d.Set("netmask", prefixlengthtohexip(d.Get("prefix_size").(int)))
+					if goffset != 0 {
+						d.Set("gateway", gateway)
+					}
+				}
 				return nil
 			}
 		}
 
+		// Restoring the merged siblings
+		resourceipsubnetmergerollback(d, merged, meta)
+
 		// Reporting a failure
 		if len(buf) > 0 {
 			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
@@ -344,18 +829,21 @@ func resourceipsubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("SOLIDServer - Unable to update IP subnet: %s\n", d.Get("name").(string))
 	}
 
+	// Restoring the merged siblings
+	resourceipsubnetmergerollback(d, merged, meta)
+
 	// Reporting a failure
 	return err
 }
 
-func resourceipsubnetgatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceipsubnetgatewayDelete(d *schema.ResourceData, gateway string, meta interface{}) error {
 	s := meta.(*SOLIDserver)
 
-	if d.Get("gateway") != nil {
+	if gateway != "" {
 		// Building parameters
 		parameters := url.Values{}
 		parameters.Add("site_name", d.Get("space").(string))
-		parameters.Add("hostaddr", d.Get("gateway").(string))
+		parameters.Add("hostaddr", gateway)
 
 		// Sending the deletion request
 		resp, body, err := s.Request("delete", "rest/ip_delete", &parameters)
@@ -369,15 +857,15 @@ func resourceipsubnetgatewayDelete(d *schema.ResourceData, meta interface{}) err
 				// Reporting a failure
 				if len(buf) > 0 {
 					if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-						log.Printf("[DEBUG] SOLIDServer - Unable to delete IP subnet's gateway: %s (%s)", d.Get("gateway").(string), errMsg)
+						log.Printf("[DEBUG] SOLIDServer - Unable to delete IP subnet's gateway: %s (%s)", gateway, errMsg)
 					}
 				}
 
-				log.Printf("[DEBUG] SOLIDServer - Unable to delete IP subnet's gateway: %s", d.Get("gateway").(string))
+				log.Printf("[DEBUG] SOLIDServer - Unable to delete IP subnet's gateway: %s", gateway)
 			}
 
 			// Log deletion
-			log.Printf("[DEBUG] SOLIDServer - Deleted IP subnet's gateway: %s\n", d.Get("gateway").(string))
+			log.Printf("[DEBUG] SOLIDServer - Deleted IP subnet's gateway: %s\n", gateway)
 
 			// Reporting a success
 			return nil
@@ -396,7 +884,7 @@ func resourceipsubnetDelete(d *schema.ResourceData, meta interface{}) error {
 
 	// Delete related resources such as the Gateway
 	if d.Get("gateway_offset") != 0 {
-		resourceipsubnetgatewayDelete(d, meta)
+		resourceipsubnetgatewayDelete(d, d.Get("gateway").(string), meta)
 	}
 
 	// Building parameters
@@ -456,6 +944,13 @@ func resourceipsubnetRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("block", buf[0]["parent_subnet_name"].(string))
 			d.Set("name", buf[0]["subnet_name"].(string))
 			d.Set("class", buf[0]["subnet_class_name"].(string))
+			subnetvlanset(d, buf[0])
+
+			// Reading the VLSM subnet of the parent space the IP subnet is linked to
+			if vlsmErr := resourceipsubnetvlsmset(d, buf[0], meta); vlsmErr != nil {
+				// Reporting a failure
+				return vlsmErr
+			}
 
 			if buf[0]["is_terminal"].(string) == "1" {
 				d.Set("terminal", true)
@@ -508,6 +1003,29 @@ func resourceipsubnetRead(d *schema.ResourceData, meta interface{}) error {
 func resourceipsubnetImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (space/address/prefix_size) of the IP subnet if required
+	oid, oidErr := importidresolve(d.Id(), "space/address/prefix_size", "IP subnet", func(keys []string) (string, error) {
+		siteID, siteErr := ipsiteidbyname(keys[0], meta)
+		prefixSize, prefixErr := strconv.Atoi(keys[2])
+		if siteID == "" || prefixErr != nil {
+			return "", siteErr
+		}
+
+		// Looking for a terminal subnet first, then for a block
+		if subnetID, subnetErr := ipsubnetidbyprefix(siteID, iptohexip(keys[1]), prefixSize, true, meta); subnetID != "" || subnetErr != nil {
+			return subnetID, subnetErr
+		}
+
+		return ipsubnetidbyprefix(siteID, iptohexip(keys[1]), prefixSize, false, meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("subnet_id", d.Id())
@@ -538,6 +1056,13 @@ func resourceipsubnetImportState(d *schema.ResourceData, meta interface{}) ([]*s
 			d.Set("gateway_offset", 0)
 
 			d.Set("class", buf[0]["subnet_class_name"].(string))
+			subnetvlanset(d, buf[0])
+
+			// Reading the VLSM subnet of the parent space the IP subnet is linked to
+			if vlsmErr := resourceipsubnetvlsmset(d, buf[0], meta); vlsmErr != nil {
+				// Reporting a failure
+				return nil, vlsmErr
+			}
 
 			// Setting local class_parameters
 			currentClassParameters := d.Get("class_parameters").(map[string]interface{})
@@ -446,6 +446,18 @@ func resourceuserRead(d *schema.ResourceData, meta interface{}) error {
 func resourceuserImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (login) of the user if required
+	oid, oidErr := importidresolve(d.Id(), "login", "user", func(keys []string) (string, error) {
+		return objectidbywhere("user_list", "usr_id", "usr_login='"+keys[0]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("usr_id", d.Id())
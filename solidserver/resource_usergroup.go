@@ -230,6 +230,18 @@ func resourceusergroupImportState(d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (name) of the user group if required
+	oid, oidErr := importidresolve(d.Id(), "name", "user group", func(keys []string) (string, error) {
+		return objectidbywhere("group_admin_list", "grp_id", "grp_name='"+keys[0]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("grp_id", d.Id())
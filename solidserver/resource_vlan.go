@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 func resourcevlan() *schema.Resource {
@@ -60,6 +61,22 @@ func resourcevlan() *schema.Resource {
 				ForceNew:    false,
 				Default:     map[string]string{},
 			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Description: "The prefixes of the IP subnets associated to the vlan.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"subnets6": {
+				Type:        schema.TypeList,
+				Description: "The prefixes of the IPv6 subnets associated to the vlan.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -281,6 +298,9 @@ func resourcevlanRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("name", buf[0]["vlmvlan_name"].(string))
 			d.Set("vlan_id", vnid)
 
+			// Retrieving the associated subnets
+			vlansubnetsset(d, buf[0], meta)
+
 			if s.Version < 730 {
 				log.Printf("[INFO] SOLIDServer - Ignoring class_parameters for vlan: %s as not supported by your SOLIDserver version\n", d.Get("name").(string))
 			} else {
@@ -328,6 +348,18 @@ func resourcevlanRead(d *schema.ResourceData, meta interface{}) error {
 func resourcevlanImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (vlan_domain/vlan_id) of the vlan if required
+	oid, oidErr := importidresolve(d.Id(), "vlan_domain/vlan_id", "vlan", func(keys []string) (string, error) {
+		return objectidbywhere("vlmvlan_list", "vlmvlan_id", "vlmdomain_name='"+strings.ToLower(keys[0])+"' AND vlmvlan_vlan_id='"+keys[1]+"'", meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("vlmvlan_id", d.Id())
@@ -346,6 +378,9 @@ func resourcevlanImportState(d *schema.ResourceData, meta interface{}) ([]*schem
 			d.Set("name", buf[0]["vlmvlan_name"].(string))
 			d.Set("vlan_id", vnid)
 
+			// Retrieving the associated subnets
+			vlansubnetsset(d, buf[0], meta)
+
 			if s.Version < 730 {
 				log.Printf("[INFO] SOLIDServer - Ignoring class_parameters for vlan: %s as not supported by your SOLIDserver version\n", d.Get("name").(string))
 			} else {
@@ -385,3 +420,18 @@ func resourcevlanImportState(d *schema.ResourceData, meta interface{}) ([]*schem
 	// Reporting a failure
 	return nil, err
 }
+
+// Set the prefixes of the IP and IPv6 subnets associated to a vlan from the retrieved information
+func vlansubnetsset(d *schema.ResourceData, info map[string]interface{}, meta interface{}) {
+	vlmdomainName, _ := info["vlmdomain_name"].(string)
+	vlmvlanID, _ := info["vlmvlan_vlan_id"].(string)
+	vlanID, _ := strconv.Atoi(vlmvlanID)
+
+	if subnets, subnetsErr := ipsubnetsbyvlan(vlmdomainName, vlanID, meta); subnetsErr == nil {
+		d.Set("subnets", toStringArrayInterface(subnets))
+	}
+
+	if subnets6, subnets6Err := ip6subnetsbyvlan(vlmdomainName, vlanID, meta); subnets6Err == nil {
+		d.Set("subnets6", toStringArrayInterface(subnets6))
+	}
+}
@@ -294,6 +294,18 @@ func resourcevlandomainRead(d *schema.ResourceData, meta interface{}) error {
 func resourcevlandomainImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := meta.(*SOLIDserver)
 
+	// Resolving the natural key (name) of the vlan domain if required
+	oid, oidErr := importidresolve(d.Id(), "name", "vlan domain", func(keys []string) (string, error) {
+		return vlandomainidbyname(keys[0], meta)
+	})
+
+	if oidErr != nil {
+		// Reporting a failure
+		return nil, oidErr
+	}
+
+	d.SetId(oid)
+
 	// Building parameters
 	parameters := url.Values{}
 	parameters.Add("vlmdomain_id", d.Id())
@@ -39,8 +39,69 @@ var httpRequestTimings = map[string]struct {
 }
 
 const regexpIPPort = `^!?(([0-9]{1,3})\.){3}[0-9]{1,3}:[0-9]{1,5}$`
+const regexpMasterIPPort = `^(([0-9]{1,3})\.){3}[0-9]{1,3}:[0-9]{1,5}$`
 const regexpHostname = `^(([a-z0-9]|[a-z0-9][a-z0-9\-]*[a-z0-9])\.)*([a-z0-9]|[a-z0-9][a-z0-9\-]*[a-z0-9])$`
 const regexpNetworkAcl = `^!?(([0-9]{1,3})\.){3}[0-9]{1,3}/[0-9]{1,2}$`
+const regexpKeyAcl = `^!?key [A-Za-z0-9][A-Za-z0-9._\-]*$`
+const regexpNamedAcl = `^!?[A-Za-z][A-Za-z0-9._\-]*$`
+
+// Number of objects retrieved per request when listing objects
+const listPageSize = 1000
+
+// Maximum number of reverse zones computed for a single IP or IPv6 prefix
+const reverseZoneMaxCount = 256
+
+// Type specific attributes of the structured RR types, in the order of their value1 to valueN fields
+var dnsrrtypeattributes = map[string][]string{
+	"MX":    {"priority", "target"},
+	"SRV":   {"priority", "weight", "port", "target"},
+	"CAA":   {"flags", "tag", "value"},
+	"NAPTR": {"order", "preference", "flags", "services", "regexp", "replacement"},
+	"SSHFP": {"algorithm", "fingerprint_type", "fingerprint"},
+	"TLSA":  {"usage", "selector", "matching_type", "certificate"},
+	"DS":    {"key_tag", "algorithm", "digest_type", "digest"},
+}
+
+// CNAME values encoding the RPZ policy actions, any other value being a redirection (CNAME action)
+var dnsrpzactionvalues = map[string]string{
+	"NXDOMAIN": ".",
+	"NODATA":   "*.",
+	"PASSTHRU": "rpz-passthru.",
+	"DROP":     "rpz-drop.",
+}
+
+// Labels appended to the RPZ rule names encoding their trigger, QNAME triggers having none
+var dnsrpztriggerlabels = map[string]string{
+	"IP":      "rpz-ip",
+	"NSIP":    "rpz-nsip",
+	"NSDNAME": "rpz-nsdname",
+}
+
+// DNS zone parameters holding the SOA attributes of a zone
+var dnszonesoaparams = map[string]string{
+	"primary_ns": "soa_mname",
+	"contact":    "soa_rname",
+	"refresh":    "soa_refresh",
+	"retry":      "soa_retry",
+	"expire":     "soa_expire",
+	"minimum":    "soa_minimum",
+}
+
+// DNS zone parameters holding the ACLs of a zone, named after the zone's ACL attributes
+var dnszoneaclparams = []string{"allow_query", "allow_transfer", "allow_update"}
+
+// Supported TSIG algorithms and the size in bytes of their generated secrets
+var dnstsigalgorithms = map[string]int{
+	"hmac-md5":    16,
+	"hmac-sha1":   20,
+	"hmac-sha224": 28,
+	"hmac-sha256": 32,
+	"hmac-sha384": 48,
+	"hmac-sha512": 64,
+}
+
+// Class parameter holding the UNIX time until which a released IP address is kept in quarantine
+const quarantineClassParameter = "quarantine_until"
 
 type SOLIDserver struct {
 	Host                     string
@@ -1,16 +1,24 @@
 package solidserver
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"inet.af/netaddr"
 	"log"
 	"math/big"
+	"net"
 	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Integer Absolute value
@@ -290,6 +298,11 @@ func resourcediffsuppresscase(k, old, new string, d *schema.ResourceData) bool {
 	return false
 }
 
+// Ignore the case and the trailing dot of a domain name
+func resourcediffsuppressfqdn(k, old, new string, d *schema.ResourceData) bool {
+	return resourcediffsuppresscase(k, strings.TrimSuffix(old, "."), strings.TrimSuffix(new, "."), d)
+}
+
 func resourcediffsuppressIPv6Format(k, old, new string, d *schema.ResourceData) bool {
 	oldipv6, _ := netaddr.ParseIP(old)
 	newipv6, _ := netaddr.ParseIP(new)
@@ -1319,9 +1332,9 @@ func dnsserverpendingdeletions(serverID string, meta interface{}) int {
 	return result
 }
 
-// Set a DNSserver or DNSview param value
+// Set a DNSserver, DNSview or DNSzone param value
 // Return false in case of failure
-func dnsparamset(serverName string, viewID string, paramKey string, paramValue string, meta interface{}) bool {
+func dnsparamset(serverName string, viewID string, zoneID string, paramKey string, paramValue string, meta interface{}) bool {
 	s := meta.(*SOLIDserver)
 
 	service := "dns_server_param_add"
@@ -1329,7 +1342,10 @@ func dnsparamset(serverName string, viewID string, paramKey string, paramValue s
 	// Building parameters to push information
 	parameters := url.Values{}
 
-	if viewID != "" {
+	if zoneID != "" {
+		service = "dns_zone_param_add"
+		parameters.Add("dnszone_id", zoneID)
+	} else if viewID != "" {
 		service = "dns_view_param_add"
 		parameters.Add("dnsview_id", viewID)
 	} else {
@@ -1354,19 +1370,19 @@ func dnsparamset(serverName string, viewID string, paramKey string, paramValue s
 		// Log the error
 		if len(buf) > 0 {
 			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-				log.Printf("[DEBUG] SOLIDServer - Unable to set DNS server or view parameter: %s on %s (%s)\n", paramKey, serverName, errMsg)
+				log.Printf("[DEBUG] SOLIDServer - Unable to set DNS server, view or zone parameter: %s on %s (%s)\n", paramKey, serverName, errMsg)
 			}
 		} else {
-			log.Printf("[DEBUG] SOLIDServer - Unable to set DNS server or view parameter: %s on %s\n", paramKey, serverName)
+			log.Printf("[DEBUG] SOLIDServer - Unable to set DNS server, view or zone parameter: %s on %s\n", paramKey, serverName)
 		}
 	}
 
 	return false
 }
 
-// UnSet a DNSserver or DNSview param value
+// UnSet a DNSserver, DNSview or DNSzone param value
 // Return false in case of failure
-func dnsparamunset(serverName string, viewID string, paramKey string, meta interface{}) bool {
+func dnsparamunset(serverName string, viewID string, zoneID string, paramKey string, meta interface{}) bool {
 	s := meta.(*SOLIDserver)
 
 	service := "dns_server_param_delete"
@@ -1374,7 +1390,10 @@ func dnsparamunset(serverName string, viewID string, paramKey string, meta inter
 	// Building parameters to push information
 	parameters := url.Values{}
 
-	if viewID != "" {
+	if zoneID != "" {
+		service = "dns_zone_param_delete"
+		parameters.Add("dnszone_id", zoneID)
+	} else if viewID != "" {
 		service = "dns_view_param_delete"
 		parameters.Add("dnsview_id", viewID)
 	} else {
@@ -1398,30 +1417,34 @@ func dnsparamunset(serverName string, viewID string, paramKey string, meta inter
 		// Log the error
 		if len(buf) > 0 {
 			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
-				log.Printf("[DEBUG] SOLIDServer - Unable to unset DNS server or view parameter: %s on %s (%s)\n", paramKey, serverName, errMsg)
+				log.Printf("[DEBUG] SOLIDServer - Unable to unset DNS server, view or zone parameter: %s on %s (%s)\n", paramKey, serverName, errMsg)
 			}
 		} else {
-			log.Printf("[DEBUG] SOLIDServer - Unable to unset DNS server or view parameter: %s on %s\n", paramKey, serverName)
+			log.Printf("[DEBUG] SOLIDServer - Unable to unset DNS server, view or zone parameter: %s on %s\n", paramKey, serverName)
 		}
 	}
 
 	return false
 }
 
-// Get a DNSserver or DNSview param's value
+// Get a DNSserver, DNSview or DNSzone param's value
 // Return an empty string and an error in case of failure
-func dnsparamget(serverName string, viewID string, paramKey string, meta interface{}) (string, error) {
+func dnsparamget(serverName string, viewID string, zoneID string, paramKey string, meta interface{}) (string, error) {
 	s := meta.(*SOLIDserver)
 
 	service := "dns_server_param_list"
-	if viewID != "" {
+	if zoneID != "" {
+		service = "dns_zone_param_list"
+	} else if viewID != "" {
 		service = "dns_view_param_list"
 	}
 
 	// Building parameters for retrieving information
 	parameters := url.Values{}
 
-	if viewID == "" {
+	if zoneID != "" {
+		parameters.Add("WHERE", "dns_name='"+serverName+"' AND dnszone_id='"+zoneID+"' AND param_key='"+paramKey+"'")
+	} else if viewID == "" {
 		parameters.Add("WHERE", "dns_name='"+serverName+"' AND param_key='"+paramKey+"'")
 	} else {
 		parameters.Add("WHERE", "dns_name='"+serverName+"' AND dnsview_id='"+viewID+"' AND param_key='"+paramKey+"'")
@@ -1618,3 +1641,2359 @@ func dnsdeletefromsmart(smartName string, serverName string, meta interface{}) b
 
 	return false
 }
+
+// Return the number of used IP addresses of a subnet located outside of the given hexa range
+// Return -1 in case of failure
+func ipaddresscountoutofrange(subnetID string, startHexAddr string, endHexAddr string, meta interface{}) int {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "subnet_id='"+subnetID+"' AND type!='free' AND (ip_addr<'"+startHexAddr+"' OR ip_addr>'"+endHexAddr+"')")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_address_count", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if total, totalExist := buf[0]["total"].(string); totalExist {
+				count, _ := strconv.Atoi(total)
+				return count
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to count IP addresses of subnet (oid): %s\n", subnetID)
+
+	return -1
+}
+
+// Return the number of child IP subnets of a block located outside of the given hexa range
+// Return -1 in case of failure
+func ipsubnetcountoutofrange(blockID string, startHexAddr string, endHexAddr string, meta interface{}) int {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "parent_subnet_id='"+blockID+"' AND (start_ip_addr<'"+startHexAddr+"' OR end_ip_addr>'"+endHexAddr+"')")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_block_subnet_count", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if total, totalExist := buf[0]["total"].(string); totalExist {
+				count, _ := strconv.Atoi(total)
+				return count
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to count child IP subnets of block (oid): %s\n", blockID)
+
+	return -1
+}
+
+// Return the list of IP subnets sharing the same parent as the given subnet and overlapping the given hexa range
+// Or nil in case of failure
+func ipsubnetsiblingsinrange(siteID string, subnetID string, parentID string, startHexAddr string, endHexAddr string, meta interface{}) ([]map[string]interface{}, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+
+	whereClause := "site_id='" + siteID + "' AND subnet_id!='" + subnetID + "'"
+
+	if parentID != "" {
+		whereClause += " AND parent_subnet_id='" + parentID + "'"
+	} else {
+		whereClause += " AND subnet_level='0'"
+	}
+
+	whereClause += " AND start_ip_addr<='" + endHexAddr + "' AND end_ip_addr>='" + startHexAddr + "'"
+
+	parameters.Add("WHERE", whereClause)
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_block_subnet_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 || resp.StatusCode == 204 {
+			return buf, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return nil, fmt.Errorf("SOLIDServer - Unable to list IP subnets overlapping range: %s-%s (%s)\n", hexiptoip(startHexAddr), hexiptoip(endHexAddr), errMsg)
+			}
+		}
+
+		return nil, fmt.Errorf("SOLIDServer - Unable to list IP subnets overlapping range: %s-%s\n", hexiptoip(startHexAddr), hexiptoip(endHexAddr))
+	}
+
+	return nil, err
+}
+
+// Add the vlan association parameters of a terminal IP or IPv6 subnet to the given parameters
+// Return an error if the vlan association is not applicable
+func subnetvlanparameters(d *schema.ResourceData, parameters *url.Values) error {
+	vlmdomainName := d.Get("vlan_domain").(string)
+
+	if vlmdomainName == "" {
+		parameters.Add("vlmdomain_name", "")
+		parameters.Add("vlmvlan_vlan_id", "0")
+		return nil
+	}
+
+	if !d.Get("terminal").(bool) {
+		return fmt.Errorf("SOLIDServer - Unable to associate subnet: %s with a vlan, only terminal subnets can be associated\n", d.Get("name").(string))
+	}
+
+	parameters.Add("vlmdomain_name", vlmdomainName)
+
+	// Prefer the vlan name when explicitly changed, the vlan ID otherwise
+	if d.HasChange("vlan_name") && d.Get("vlan_name").(string) != "" {
+		parameters.Add("vlmvlan_name", d.Get("vlan_name").(string))
+	} else if d.Get("vlan_id").(int) > 0 {
+		parameters.Add("vlmvlan_vlan_id", strconv.Itoa(d.Get("vlan_id").(int)))
+	} else if d.Get("vlan_name").(string) != "" {
+		parameters.Add("vlmvlan_name", d.Get("vlan_name").(string))
+	} else {
+		return fmt.Errorf("SOLIDServer - Unable to associate subnet: %s with a vlan, vlan_id or vlan_name is required\n", d.Get("name").(string))
+	}
+
+	return nil
+}
+
+// Set the vlan association attributes of an IP or IPv6 subnet from the retrieved information
+func subnetvlanset(d *schema.ResourceData, info map[string]interface{}) {
+	vlmdomainName, _ := info["vlmdomain_name"].(string)
+	vlmvlanName, _ := info["vlmvlan_name"].(string)
+	vlmvlanID, _ := info["vlmvlan_vlan_id"].(string)
+	vnid, _ := strconv.Atoi(vlmvlanID)
+
+	d.Set("vlan_domain", vlmdomainName)
+	d.Set("vlan_id", vnid)
+	d.Set("vlan_name", vlmvlanName)
+}
+
+// Return the prefixes of the IP subnets associated to a vlan from vlmdomain_name and vlan_id
+// Or an empty table of strings in case of failure
+func ipsubnetsbyvlan(vlmdomainName string, vlanID int, meta interface{}) ([]string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "vlmdomain_name='"+strings.ToLower(vlmdomainName)+"' AND vlmvlan_vlan_id='"+strconv.Itoa(vlanID)+"' AND is_terminal='1'")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_block_subnet_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 || resp.StatusCode == 204 {
+			prefixes := []string{}
+
+			for i := range buf {
+				if startAddr, startAddrExist := buf[i]["start_ip_addr"].(string); startAddrExist {
+					size, _ := strconv.Atoi(buf[i]["subnet_size"].(string))
+					prefixes = append(prefixes, hexiptoip(startAddr)+"/"+strconv.Itoa(sizetoprefixlength(size)))
+				}
+			}
+
+			return prefixes, nil
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to list IP subnets associated to vlan: %d in vlan domain: %s\n", vlanID, vlmdomainName)
+
+	return []string{}, err
+}
+
+// Return the prefixes of the IPv6 subnets associated to a vlan from vlmdomain_name and vlan_id
+// Or an empty table of strings in case of failure
+func ip6subnetsbyvlan(vlmdomainName string, vlanID int, meta interface{}) ([]string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "vlmdomain_name='"+strings.ToLower(vlmdomainName)+"' AND vlmvlan_vlan_id='"+strconv.Itoa(vlanID)+"' AND is_terminal='1'")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip6_block6_subnet6_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 || resp.StatusCode == 204 {
+			prefixes := []string{}
+
+			for i := range buf {
+				if startAddr, startAddrExist := buf[i]["start_ip6_addr"].(string); startAddrExist {
+					prefixes = append(prefixes, hexip6toip6(startAddr)+"/"+buf[i]["subnet6_prefix"].(string))
+				}
+			}
+
+			return prefixes, nil
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to list IPv6 subnets associated to vlan: %d in vlan domain: %s\n", vlanID, vlmdomainName)
+
+	return []string{}, err
+}
+
+// Return the oid of the parent space of a space from site_id
+// Or an empty string in case of failure or if the space has no parent
+func ipsiteparentidbyid(siteID string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("site_id", siteID)
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_site_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if parentSiteID, parentSiteIDExist := buf[0]["parent_site_id"].(string); parentSiteIDExist && parentSiteID != "0" {
+				return parentSiteID, nil
+			}
+
+			return "", nil
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to find parent of IP space (oid): %s\n", siteID)
+
+	return "", err
+}
+
+// Return the name of an IP subnet from subnet_id
+// Or an empty string in case of failure
+func ipsubnetnamebyid(subnetID string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("subnet_id", subnetID)
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_block_subnet_info", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if subnetName, subnetNameExist := buf[0]["subnet_name"].(string); subnetNameExist {
+				return subnetName, nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to find IP subnet (oid): %s\n", subnetID)
+
+	return "", err
+}
+
+// Return the oid of a DNS RR from dns_name, dnsview_name, rr_full_name, rr_type and value1
+// Or an empty string in case of failure
+func dnsrridbyinfo(serverName string, viewName string, rrName string, rrType string, value string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	whereClause := "dns_name='" + serverName + "' AND rr_full_name='" + rrName + "' AND rr_type='" + strings.ToUpper(rrType) + "'"
+
+	if strings.ToUpper(rrType) == "AAAA" {
+		whereClause += " AND value1='" + shortip6tolongip6(value) + "'"
+	} else {
+		for i, field := range dnsrrvaluefields(rrType, value) {
+			whereClause += " AND value" + strconv.Itoa(i+1) + "='" + field + "'"
+		}
+	}
+
+	if len(viewName) != 0 {
+		whereClause += " AND dnsview_name='" + viewName + "'"
+	} else {
+		whereClause += " AND dnsview_name='#'"
+	}
+
+	parameters.Add("WHERE", whereClause)
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_rr_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if rrID, rrIDExist := buf[0]["rr_id"].(string); rrIDExist {
+				return rrID, nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to find RR: %s (%s)\n", rrName, rrType)
+
+	return "", err
+}
+
+// Create a DNS RR or update it in place when its oid is provided
+// Return the oid of the RR or an error in case of failure
+func dnsrrset(rrID string, serverName string, viewName string, zoneName string, rrName string, rrType string, value string, ttl int, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	method := "post"
+
+	// Building parameters
+	parameters := url.Values{}
+
+	if rrID != "" {
+		method = "put"
+		parameters.Add("rr_id", rrID)
+		parameters.Add("add_flag", "edit_only")
+	} else {
+		parameters.Add("add_flag", "new_only")
+	}
+
+	parameters.Add("dns_name", serverName)
+	parameters.Add("rr_name", rrName)
+	parameters.Add("rr_type", strings.ToUpper(rrType))
+	parameters.Add("rr_ttl", strconv.Itoa(ttl))
+
+	for i, field := range dnsrrvaluefields(rrType, value) {
+		parameters.Add("value"+strconv.Itoa(i+1), field)
+	}
+
+	// Add dnsview parameter if it is supplied
+	if len(viewName) != 0 {
+		parameters.Add("dnsview_name", strings.ToLower(viewName))
+	}
+
+	// Add dnszone parameter if it is supplied
+	if len(zoneName) != 0 {
+		parameters.Add("dnszone_name", strings.ToLower(zoneName))
+	}
+
+	// Sending the creation/update request
+	resp, body, err := s.Request(method, "rest/dns_rr_add", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Registered RR (oid): %s\n", oid)
+				return oid, nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return "", fmt.Errorf("SOLIDServer - Unable to register RR: %s (%s)", rrName, errMsg)
+			}
+		}
+
+		return "", fmt.Errorf("SOLIDServer - Unable to register RR: %s\n", rrName)
+	}
+
+	// Reporting a failure
+	return "", err
+}
+
+// Delete a DNS RR from dns_name, dnsview_name, rr_full_name, rr_type and value1 if it exists
+// Return an error in case of failure
+func dnsrrdeletebyinfo(serverName string, viewName string, rrName string, rrType string, value string, meta interface{}) error {
+	rrID, rrErr := dnsrridbyinfo(serverName, viewName, rrName, rrType, value, meta)
+
+	if rrErr != nil || rrID == "" {
+		// Nothing to delete
+		return rrErr
+	}
+
+	return dnsrrdeletebyid(rrID, viewName, rrName, meta)
+}
+
+// Delete a DNS RR from its oid
+// Return an error in case of failure
+func dnsrrdeletebyid(rrID string, viewName string, rrName string, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("rr_id", rrID)
+
+	// Add dnsview parameter if it is supplied
+	if len(viewName) != 0 {
+		parameters.Add("dnsview_name", strings.ToLower(viewName))
+	}
+
+	// Sending the deletion request
+	resp, body, err := s.Request("delete", "rest/dns_rr_delete", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			// Reporting a failure
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return fmt.Errorf("SOLIDServer - Unable to delete RR: %s (%s)", rrName, errMsg)
+				}
+			}
+
+			return fmt.Errorf("SOLIDServer - Unable to delete RR: %s", rrName)
+		}
+
+		// Log deletion
+		log.Printf("[DEBUG] SOLIDServer - Deleted RR (oid): %s\n", rrID)
+
+		return nil
+	}
+
+	// Reporting a failure
+	return err
+}
+
+// Compute the PTR record name of an IP or IPv6 address
+func addresstoptr(address string, rrType string) string {
+	if strings.ToUpper(rrType) == "AAAA" {
+		return ip6toptr(shortip6tolongip6(address))
+	}
+
+	return iptoptr(address)
+}
+
+// Qualify a DNS name with its zone unless it already is a name of this zone
+func dnsrrfqdn(name string, zoneName string) string {
+	name = strings.TrimSuffix(name, ".")
+	zoneName = strings.TrimSuffix(zoneName, ".")
+
+	if zoneName == "" || strings.EqualFold(name, zoneName) || strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(zoneName)) {
+		return name
+	}
+
+	return name + "." + zoneName
+}
+
+// Return the name of the most specific zone of a DNS server and view holding a DNS name
+// Or an empty string if none
+func dnszonenamebyrr(serverName string, viewName string, rrName string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+	zoneName := ""
+
+	// Listing the zones matching any parent domain of the name
+	labels := strings.Split(strings.ToLower(strings.TrimSuffix(rrName, ".")), ".")
+	zoneClause := []string{}
+
+	for i := 0; i < len(labels); i++ {
+		zoneClause = append(zoneClause, "dnszone_name='"+strings.Join(labels[i:], ".")+"'")
+	}
+
+	if len(viewName) == 0 {
+		viewName = "#"
+	}
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "dns_name='"+serverName+"' AND dnsview_name='"+strings.ToLower(viewName)+"' AND ("+strings.Join(zoneClause, " OR ")+")")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_zone_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 || resp.StatusCode == 204 {
+			for _, zone := range buf {
+				if name, nameExist := zone["dnszone_name"].(string); nameExist && len(name) > len(zoneName) {
+					zoneName = name
+				}
+			}
+
+			return zoneName, nil
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return "", fmt.Errorf("SOLIDServer - Unable to find the zone of RR: %s (%s)\n", rrName, errMsg)
+			}
+		}
+
+		return "", fmt.Errorf("SOLIDServer - Unable to find the zone of RR: %s\n", rrName)
+	}
+
+	// Reporting a failure
+	return "", err
+}
+
+// Synchronize the A/AAAA and PTR records of an IP or IPv6 address with its name, address and TTL
+// The address is retrieved from the addressKey attribute of the resource, short names are qualified with the DNS zone
+// Return an error in case of failure
+func ipaddressdnssync(d *schema.ResourceData, rrType string, addressKey string, meta interface{}) error {
+	oldServer, _ := d.GetChange("dns_server")
+	oldView, _ := d.GetChange("dns_view")
+	oldZone, _ := d.GetChange("dns_zone")
+	oldName, _ := d.GetChange("name")
+	oldAddress, _ := d.GetChange(addressKey)
+	oldPtr, _ := d.GetChange("create_ptr")
+
+	server := d.Get("dns_server").(string)
+	view := d.Get("dns_view").(string)
+	zone := d.Get("dns_zone").(string)
+	name := dnsrrfqdn(d.Get("name").(string), zone)
+	address := d.Get(addressKey).(string)
+	createPtr := d.Get("create_ptr").(bool)
+	ttl := d.Get("dns_ttl").(int)
+
+	oldFqdn := dnsrrfqdn(oldName.(string), oldZone.(string))
+
+	// Records must be recreated when moving to another DNS server or view
+	relocate := oldServer.(string) != server || oldView.(string) != view
+
+	if oldServer.(string) != "" && oldAddress.(string) != "" && relocate {
+		if err := dnsrrdeletebyinfo(oldServer.(string), oldView.(string), oldFqdn, rrType, oldAddress.(string), meta); err != nil {
+			return err
+		}
+
+		if oldPtr.(bool) {
+			if err := dnsrrdeletebyinfo(oldServer.(string), oldView.(string), addresstoptr(oldAddress.(string), rrType), "PTR", oldFqdn, meta); err != nil {
+				return err
+			}
+		}
+	}
+
+	if server == "" {
+		return nil
+	}
+
+	// Updating the forward record in place when it exists
+	rrID := ""
+
+	if !relocate && oldAddress.(string) != "" {
+		rrID, _ = dnsrridbyinfo(server, view, oldFqdn, rrType, oldAddress.(string), meta)
+	}
+
+	if _, err := dnsrrset(rrID, server, view, zone, name, rrType, address, ttl, meta); err != nil {
+		return err
+	}
+
+	// Updating the PTR record in place when it exists
+	ptrID := ""
+
+	if !relocate && oldPtr.(bool) && oldAddress.(string) != "" {
+		ptrID, _ = dnsrridbyinfo(server, view, addresstoptr(oldAddress.(string), rrType), "PTR", oldFqdn, meta)
+	}
+
+	if createPtr {
+		ptrName := addresstoptr(address, rrType)
+		ptrZone, zoneErr := dnszonenamebyrr(server, view, ptrName, meta)
+
+		if zoneErr != nil {
+			return zoneErr
+		}
+
+		if ptrZone == "" {
+			return fmt.Errorf("SOLIDServer - Unable to find the reverse zone of PTR record: %s\n", ptrName)
+		}
+
+		if _, err := dnsrrset(ptrID, server, view, ptrZone, ptrName, "PTR", name, ttl, meta); err != nil {
+			return err
+		}
+	} else if ptrID != "" {
+		if err := dnsrrdeletebyid(ptrID, view, addresstoptr(oldAddress.(string), rrType), meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete the A/AAAA and PTR records of an IP or IPv6 address stored in the addressKey attribute
+// Return an error if a record can't be found or in case of failure
+func ipaddressdnsdelete(d *schema.ResourceData, rrType string, addressKey string, meta interface{}) error {
+	server := d.Get("dns_server").(string)
+	view := d.Get("dns_view").(string)
+	name := dnsrrfqdn(d.Get("name").(string), d.Get("dns_zone").(string))
+	address := d.Get(addressKey).(string)
+
+	if server == "" || address == "" {
+		return nil
+	}
+
+	if err := dnsrrdeletebyinfo(server, view, name, rrType, address, meta); err != nil {
+		return err
+	}
+
+	if d.Get("create_ptr").(bool) {
+		return dnsrrdeletebyinfo(server, view, addresstoptr(address, rrType), "PTR", name, meta)
+	}
+
+	return nil
+}
+
+// Return the number of used IP addresses of a space located within the given hexa range
+// Return -1 in case of failure
+func ipaddresscountinrange(siteID string, startHexAddr string, endHexAddr string, meta interface{}) int {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "site_id='"+siteID+"' AND type!='free' AND ip_addr>='"+startHexAddr+"' AND ip_addr<='"+endHexAddr+"'")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_address_count", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if total, totalExist := buf[0]["total"].(string); totalExist {
+				count, _ := strconv.Atoi(total)
+				return count
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to count IP addresses of space (oid): %s\n", siteID)
+
+	return -1
+}
+
+// Return the number of terminal IP subnets of a space located within the given hexa range
+// Return -1 in case of failure
+func ipsubnetcountterminalinrange(siteID string, startHexAddr string, endHexAddr string, meta interface{}) int {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "site_id='"+siteID+"' AND is_terminal='1' AND start_ip_addr>='"+startHexAddr+"' AND end_ip_addr<='"+endHexAddr+"'")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_block_subnet_count", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if total, totalExist := buf[0]["total"].(string); totalExist {
+				count, _ := strconv.Atoi(total)
+				return count
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to count terminal IP subnets of space (oid): %s\n", siteID)
+
+	return -1
+}
+
+// Return the size of a space, computed as the sum of the size of its top level IP blocks
+// Or -1 in case of failure
+func ipspacesize(siteID string, meta interface{}) (int, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "site_id='"+siteID+"' AND is_terminal='0' AND subnet_level='0'")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_block_subnet_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 || resp.StatusCode == 204 {
+			size := 0
+
+			for i := 0; i < len(buf); i++ {
+				if blockSize, blockSizeExist := buf[i]["subnet_size"].(string); blockSizeExist {
+					blockSizeInt, _ := strconv.Atoi(blockSize)
+					size += blockSizeInt
+				}
+			}
+
+			return size, nil
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to compute the size of space (oid): %s\n", siteID)
+
+	return -1, err
+}
+
+// Return the number of used IPv6 addresses of a space located within the given hexa range
+// Return -1 in case of failure
+func ip6addresscountinrange(siteID string, startHexAddr string, endHexAddr string, meta interface{}) int {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "site_id='"+siteID+"' AND type!='free' AND ip6_addr>='"+startHexAddr+"' AND ip6_addr<='"+endHexAddr+"'")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip6_address6_count", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if total, totalExist := buf[0]["total"].(string); totalExist {
+				count, _ := strconv.Atoi(total)
+				return count
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to count IPv6 addresses of space (oid): %s\n", siteID)
+
+	return -1
+}
+
+// Return the number of terminal IPv6 subnets of a space located within the given hexa range
+// Return -1 in case of failure
+func ip6subnetcountterminalinrange(siteID string, startHexAddr string, endHexAddr string, meta interface{}) int {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "site_id='"+siteID+"' AND is_terminal='1' AND start_ip6_addr>='"+startHexAddr+"' AND end_ip6_addr<='"+endHexAddr+"'")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip6_block6_subnet6_count", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if total, totalExist := buf[0]["total"].(string); totalExist {
+				count, _ := strconv.Atoi(total)
+				return count
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to count terminal IPv6 subnets of space (oid): %s\n", siteID)
+
+	return -1
+}
+
+// Return the IPv6 size of a space, computed as the sum of the size of its top level IPv6 blocks
+// Or nil in case of failure
+func ip6spacesize(siteID string, meta interface{}) (*big.Int, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "site_id='"+siteID+"' AND is_terminal='0' AND subnet_level='0'")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip6_block6_subnet6_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 || resp.StatusCode == 204 {
+			size := big.NewInt(0)
+
+			for i := 0; i < len(buf); i++ {
+				if blockPrefix, blockPrefixExist := buf[i]["subnet6_prefix"].(string); blockPrefixExist {
+					blockPrefixInt, _ := strconv.Atoi(blockPrefix)
+					size.Add(size, new(big.Int).Lsh(big.NewInt(1), uint(128-blockPrefixInt)))
+				}
+			}
+
+			return size, nil
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to compute the size of IPv6 space (oid): %s\n", siteID)
+
+	return nil, err
+}
+
+// Compute the utilization percentage of a range from its size, used and reserved address counts
+// Return 100 when no address can be allocated within the range
+func ipusagepercent(size *big.Int, used int64, reserved int64) float64 {
+	usable := new(big.Int).Sub(size, big.NewInt(reserved))
+
+	if usable.Sign() <= 0 {
+		return 100
+	}
+
+	percent, _ := new(big.Float).Quo(new(big.Float).SetInt64(used*100), new(big.Float).SetInt(usable)).Float64()
+
+	return percent
+}
+
+// Compute the candidate IP addresses from the requested IP or from the free addresses of the subnet/pool
+// Return an error if the requested IP address is out of the subnet or pool range
+func ipaddresscandidates(siteID string, subnetName string, poolName string, requestIP string, name string, maxPercent int, meta interface{}) ([]string, error) {
+	var requestedHexIP string = iptohexip(requestIP)
+	var poolInfo map[string]interface{} = nil
+	var ipAddresses []string = nil
+
+	subnetInfo, subnetErr := ipsubnetinfobyname(siteID, subnetName, true, meta)
+
+	if subnetInfo == nil || subnetErr != nil {
+		// Reporting a failure
+		if subnetInfo == nil {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IP address: %s, unable to find requested network\n", name)
+		}
+
+		return nil, subnetErr
+	}
+
+	// Ensure the subnet utilization remains under the expected threshold
+	if maxPercent > 0 {
+		var reserved int64 = 0
+		size, _ := subnetInfo["size"].(int)
+
+		used := ipaddresscountinrange(siteID, subnetInfo["start_hex_addr"].(string), subnetInfo["end_hex_addr"].(string), meta)
+		if used < 0 {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IP address: %s, unable to compute network's utilization\n", name)
+		}
+
+		if size > 2 {
+			reserved = 2
+		}
+
+		if ipusagepercent(big.NewInt(int64(size)), int64(used+1), reserved) > float64(maxPercent) {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IP address: %s, network's utilization would exceed %d%%\n", name, maxPercent)
+		}
+	}
+
+	if len(poolName) > 0 {
+		var poolErr error = nil
+
+		poolInfo, poolErr = ippoolinfobyname(siteID, poolName, subnetName, meta)
+		if poolErr != nil {
+			// Reporting a failure
+			return nil, poolErr
+		}
+	}
+
+	// Determining if an IP address was submitted in or if we should get one from the IPAM
+	if len(requestIP) > 0 {
+		// Ensure IP Address is within the given subnet start and end IP addresses
+		if strings.Compare(subnetInfo["terminal"].(string), "1") == 0 &&
+			strings.Compare(subnetInfo["start_hex_addr"].(string), requestedHexIP) == -1 &&
+			strings.Compare(requestedHexIP, subnetInfo["end_hex_addr"].(string)) == -1 {
+
+			if poolInfo != nil && (strings.Compare(poolInfo["start_hex_addr"].(string), requestedHexIP) == 1 ||
+				strings.Compare(requestedHexIP, poolInfo["end_hex_addr"].(string)) == 1) {
+				return nil, fmt.Errorf("SOLIDServer - Unable to allocate IP address: %s, address is out of pool's range\n", name)
+			}
+
+			ipAddresses = []string{requestIP}
+		} else {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IP address: %s, address is out of network's range\n", name)
+		}
+	} else {
+		var poolID string = ""
+		var ipErr error = nil
+
+		if poolInfo != nil {
+			poolID = poolInfo["id"].(string)
+		}
+
+		ipAddresses, ipErr = ipaddressfindfree(subnetInfo["id"].(string), poolID, meta)
+
+		if ipErr != nil {
+			// Reporting a failure
+			return nil, ipErr
+		}
+	}
+
+	return ipAddresses, nil
+}
+
+// Compute the candidate IPv6 addresses from the requested IP or from the free addresses of the subnet/pool
+// Return an error if the requested IPv6 address is out of the subnet or pool range
+func ip6addresscandidates(siteID string, subnetName string, poolName string, requestIP string, name string, meta interface{}) ([]string, error) {
+	var requestedHexIP string = ip6tohexip6(requestIP)
+	var poolInfo map[string]interface{} = nil
+	var ipAddresses []string = nil
+
+	subnetInfo, subnetErr := ip6subnetinfobyname(siteID, subnetName, true, meta)
+	if subnetInfo == nil || subnetErr != nil {
+		// Reporting a failure
+		if subnetInfo == nil {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, unable to find requested network\n", name)
+		}
+
+		return nil, subnetErr
+	}
+
+	if len(poolName) > 0 {
+		var poolErr error = nil
+
+		poolInfo, poolErr = ip6poolinfobyname(siteID, poolName, subnetName, meta)
+		if poolErr != nil {
+			// Reporting a failure
+			return nil, poolErr
+		}
+	}
+
+	// Determining if an IP address was submitted in or if we should get one from the IPAM
+	if len(requestIP) > 0 {
+		// Ensure IP Address is within the given subnet start and end IP addresses
+		if strings.Compare(subnetInfo["terminal"].(string), "1") == 0 &&
+			strings.Compare(subnetInfo["start_hex_addr"].(string), requestedHexIP) == -1 &&
+			strings.Compare(requestedHexIP, subnetInfo["end_hex_addr"].(string)) == -1 {
+
+			if poolInfo != nil && (strings.Compare(poolInfo["start_hex_addr"].(string), requestedHexIP) == 1 ||
+				strings.Compare(requestedHexIP, poolInfo["end_hex_addr"].(string)) == 1) {
+				return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, address is out of pool's range\n", name)
+			}
+
+			ipAddresses = []string{requestIP}
+		} else {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, address is out of network's range\n", name)
+		}
+	} else {
+		var poolID string = ""
+		var ipErr error = nil
+
+		if poolInfo != nil {
+			poolID = poolInfo["id"].(string)
+		}
+
+		ipAddresses, ipErr = ip6addressfindfree(subnetInfo["id"].(string), poolID, meta)
+
+		if ipErr != nil {
+			// Reporting a failure
+			return nil, ipErr
+		}
+	}
+
+	return ipAddresses, nil
+}
+
+// Compute the modified EUI-64 interface identifier of a MAC address as an hexa string
+// Return an empty string in case of failure
+func mactoeui64(mac string) string {
+	hexMac := strings.ToLower(strings.NewReplacer(":", "", "-", "").Replace(mac))
+
+	if len(hexMac) != 12 {
+		return ""
+	}
+
+	firstByte, err := strconv.ParseUint(hexMac[0:2], 16, 8)
+	if err != nil {
+		return ""
+	}
+
+	// Inverting the universal/local bit and inserting fffe in the middle of the MAC address
+	return fmt.Sprintf("%02x", firstByte^0x02) + hexMac[2:6] + "fffe" + hexMac[6:12]
+}
+
+// Compute the EUI-64 IPv6 address of a MAC address within a /64 subnet as an hexa string
+// Return an empty string if the subnet can't be found or is not a /64
+func ip6subneteui64(siteID string, subnetName string, mac string, meta interface{}) string {
+	iid := mactoeui64(mac)
+	subnetInfo, _ := ip6subnetinfobyname(siteID, subnetName, true, meta)
+
+	if iid == "" || subnetInfo == nil {
+		return ""
+	}
+
+	if prefixLength, _ := subnetInfo["prefix_length"].(int); prefixLength != 64 {
+		return ""
+	}
+
+	return subnetInfo["start_hex_addr"].(string)[0:16] + iid
+}
+
+// Compute a random interface identifier as an hexa string
+// Return an empty string in case of failure
+func randomiid() string {
+	buf := make([]byte, 8)
+
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(buf)
+}
+
+// Generate candidate IPv6 addresses from the prefix of a /64 subnet using the given generation mode
+// (eui64 or random), excluding the addresses already in use within the IPAM
+// Return an error if no address can be generated
+func ip6addressgenerate(siteID string, subnetName string, mode string, mac string, name string, meta interface{}) ([]string, error) {
+	ipAddresses := []string{}
+	iids := []string{}
+
+	subnetInfo, subnetErr := ip6subnetinfobyname(siteID, subnetName, true, meta)
+	if subnetInfo == nil || subnetErr != nil {
+		// Reporting a failure
+		if subnetInfo == nil {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, unable to find requested network\n", name)
+		}
+
+		return nil, subnetErr
+	}
+
+	if prefixLength, _ := subnetInfo["prefix_length"].(int); prefixLength != 64 {
+		return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, %s generation requires a /64 network\n", name, mode)
+	}
+
+	hexPrefix := subnetInfo["start_hex_addr"].(string)[0:16]
+
+	switch mode {
+	case "eui64":
+		if iid := mactoeui64(mac); iid != "" {
+			iids = append(iids, iid)
+		} else {
+			return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, eui64 generation requires a valid MAC address\n", name)
+		}
+	case "random":
+		for i := 0; i < 8; i++ {
+			if iid := randomiid(); iid != "" {
+				iids = append(iids, iid)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, unsupported generation mode: %s\n", name, mode)
+	}
+
+	for _, iid := range iids {
+		// The null interface identifier is the subnet-router anycast address
+		if iid == "0000000000000000" {
+			continue
+		}
+
+		hexAddr := hexPrefix + iid
+
+		// Skipping the addresses already in use within the IPAM
+		if count := ip6addresscountinrange(siteID, hexAddr, hexAddr, meta); count != 0 {
+			log.Printf("[DEBUG] SOLIDServer - Skipping generated IPv6 address: %s (already in use or unverifiable)\n", hexip6toip6(hexAddr))
+			continue
+		}
+
+		ipAddresses = append(ipAddresses, hexip6toip6(hexAddr))
+	}
+
+	if len(ipAddresses) == 0 {
+		return nil, fmt.Errorf("SOLIDServer - Unable to allocate IPv6 address: %s, every generated address is already in use\n", name)
+	}
+
+	return ipAddresses, nil
+}
+
+// Compute the name of the ip6.arpa reverse zone of a nibble aligned IPv6 prefix from its hexa address and prefix length
+// Return an empty string if the prefix length is not a multiple of 4
+func ip6reversezonename(hexAddr string, prefixLength int) string {
+	labels := strings.Split(ip6toptr(hexip6toip6(hexAddr)), ".")
+	nibbles := prefixLength / 4
+
+	if len(labels) != 34 || prefixLength%4 != 0 {
+		return ""
+	}
+
+	return strings.Join(labels[32-nibbles:], ".")
+}
+
+// Return the oid of a DNS zone from its server, view and name
+// Or an empty string if it does not exist
+func dnszoneidbyname(serverName string, viewName string, zoneName string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	if viewName == "" {
+		viewName = "#"
+	}
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "dns_name='"+serverName+"' AND dnsview_name='"+strings.ToLower(viewName)+"' AND dnszone_name='"+zoneName+"'")
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/dns_zone_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if zoneID, zoneIDExist := buf[0]["dnszone_id"].(string); zoneIDExist {
+				return zoneID, nil
+			}
+		}
+
+		log.Printf("[DEBUG] SOLIDServer - Unable to find DNS zone: %s\n", zoneName)
+	}
+
+	return "", err
+}
+
+// Create a master DNS zone from its name on a DNS server and view
+// Return the zone's oid or an error in case of failure
+func dnszoneadd(serverName string, viewName string, zoneName string, siteID string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("add_flag", "new_only")
+	parameters.Add("dns_name", serverName)
+	if viewName != "" && viewName != "#" {
+		parameters.Add("dnsview_name", strings.ToLower(viewName))
+	}
+	parameters.Add("dnszone_name", zoneName)
+	parameters.Add("dnszone_type", "master")
+	parameters.Add("dnszone_site_id", siteID)
+
+	// Sending the creation request
+	resp, body, err := s.Request("post", "rest/dns_zone_add", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Created DNS zone (oid): %s\n", oid)
+				return oid, nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return "", fmt.Errorf("SOLIDServer - Unable to create DNS zone: %s (%s)", zoneName, errMsg)
+			}
+		}
+
+		return "", fmt.Errorf("SOLIDServer - Unable to create DNS zone: %s\n", zoneName)
+	}
+
+	// Reporting a failure
+	return "", err
+}
+
+// Delete a DNS zone from its oid
+// Return an error in case of failure
+func dnszonedeletebyid(zoneID string, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnszone_id", zoneID)
+
+	// Sending the deletion request
+	resp, body, err := s.Request("delete", "rest/dns_zone_delete", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			// Reporting a failure
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return fmt.Errorf("SOLIDServer - Unable to delete DNS zone (oid): %s (%s)", zoneID, errMsg)
+				}
+			}
+
+			return fmt.Errorf("SOLIDServer - Unable to delete DNS zone (oid): %s", zoneID)
+		}
+
+		// Log deletion
+		log.Printf("[DEBUG] SOLIDServer - Deleted DNS zone (oid): %s\n", zoneID)
+
+		// Reporting a success
+		return nil
+	}
+
+	// Reporting a failure
+	return err
+}
+
+// Return the name of the first IP pool of a subnet overlapping the given hexa range, ignoring the pool excludeID
+// Or an empty string if none
+func ippooloverlap(subnetID string, startHexAddr string, endHexAddr string, excludeID string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	whereClause := "subnet_id='" + subnetID + "' AND start_ip_addr<='" + endHexAddr + "' AND end_ip_addr>='" + startHexAddr + "'"
+
+	if excludeID != "" {
+		whereClause += " AND pool_id!='" + excludeID + "'"
+	}
+
+	parameters.Add("WHERE", whereClause)
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_pool_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if poolName, poolNameExist := buf[0]["pool_name"].(string); poolNameExist {
+				return poolName, nil
+			}
+		}
+
+		return "", nil
+	}
+
+	return "", err
+}
+
+// Return the name of the first IPv6 pool of a subnet overlapping the given hexa range, ignoring the pool excludeID
+// Or an empty string if none
+func ip6pooloverlap(subnetID string, startHexAddr string, endHexAddr string, excludeID string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	whereClause := "subnet6_id='" + subnetID + "' AND start_ip6_addr<='" + endHexAddr + "' AND end_ip6_addr>='" + startHexAddr + "'"
+
+	if excludeID != "" {
+		whereClause += " AND pool6_id!='" + excludeID + "'"
+	}
+
+	parameters.Add("WHERE", whereClause)
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip6_pool6_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if poolName, poolNameExist := buf[0]["pool6_name"].(string); poolNameExist {
+				return poolName, nil
+			}
+		}
+
+		return "", nil
+	}
+
+	return "", err
+}
+
+// Check at plan time that a requested address lies within the bounds of its subnet and pool
+// Parents that can't be resolved yet (ex: created within the same apply) are not checked
+func ipaddresscheckbounds(d *schema.ResourceDiff, v6 bool, meta interface{}) error {
+	var subnetInfo map[string]interface{} = nil
+	var poolInfo map[string]interface{} = nil
+	var requestedHexIP string = ""
+
+	for _, key := range []string{"space", "subnet", "pool", "request_ip"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	if d.Get("request_ip").(string) == "" {
+		return nil
+	}
+
+	siteID, siteErr := ipsiteidbyname(d.Get("space").(string), meta)
+	if siteID == "" || siteErr != nil {
+		return nil
+	}
+
+	if v6 {
+		requestedHexIP = ip6tohexip6(shortip6tolongip6(d.Get("request_ip").(string)))
+		subnetInfo, _ = ip6subnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
+
+		if d.Get("pool").(string) != "" {
+			poolInfo, _ = ip6poolinfobyname(siteID, d.Get("pool").(string), d.Get("subnet").(string), meta)
+		}
+	} else {
+		requestedHexIP = iptohexip(d.Get("request_ip").(string))
+		subnetInfo, _ = ipsubnetinfobyname(siteID, d.Get("subnet").(string), true, meta)
+
+		if d.Get("pool").(string) != "" {
+			poolInfo, _ = ippoolinfobyname(siteID, d.Get("pool").(string), d.Get("subnet").(string), meta)
+		}
+	}
+
+	if subnetInfo != nil {
+		if startHexAddr, _ := subnetInfo["start_hex_addr"].(string); startHexAddr != "" && requestedHexIP <= startHexAddr {
+			return fmt.Errorf("SOLIDServer - Requested address: %s is out of subnet: %s range", d.Get("request_ip").(string), d.Get("subnet").(string))
+		}
+
+		if endHexAddr, _ := subnetInfo["end_hex_addr"].(string); endHexAddr != "" && requestedHexIP >= endHexAddr {
+			return fmt.Errorf("SOLIDServer - Requested address: %s is out of subnet: %s range", d.Get("request_ip").(string), d.Get("subnet").(string))
+		}
+	}
+
+	if poolInfo != nil {
+		startHexAddr, _ := poolInfo["start_hex_addr"].(string)
+		endHexAddr, _ := poolInfo["end_hex_addr"].(string)
+
+		if requestedHexIP < startHexAddr || requestedHexIP > endHexAddr {
+			return fmt.Errorf("SOLIDServer - Requested address: %s is out of pool: %s range", d.Get("request_ip").(string), d.Get("pool").(string))
+		}
+	}
+
+	return nil
+}
+
+// Plan the address of an IP or IPv6 address moved by a change of its space, subnet, pool or requested IP
+// A requested address is known at plan time, the ones allocated during the move are marked as new computed
+func ipaddressplanmove(d *schema.ResourceDiff, v6 bool) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	moved := d.HasChange("space") || d.HasChange("subnet") || d.HasChange("pool")
+
+	if !moved && !d.HasChange("request_ip") {
+		return nil
+	}
+
+	if !d.NewValueKnown("request_ip") {
+		return d.SetNewComputed("address")
+	}
+
+	if requestIP := d.Get("request_ip").(string); requestIP != "" {
+		oldAddress, _ := d.GetChange("address")
+		requestedAddress := hexiptoip(iptohexip(requestIP))
+
+		if v6 {
+			requestedAddress = hexip6toip6(ip6tohexip6(shortip6tolongip6(requestIP)))
+		}
+
+		if requestedAddress != oldAddress.(string) {
+			return d.SetNew("address", requestedAddress)
+		}
+
+		return nil
+	}
+
+	// Dropping the requested IP keeps the current address
+	if moved {
+		return d.SetNewComputed("address")
+	}
+
+	return nil
+}
+
+// Return the oid of an IP subnet from site_id, start address, prefix length and is_terminal property
+// Or an empty string if none
+func ipsubnetidbyprefix(siteID string, startHexAddr string, prefixSize int, terminal bool, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	whereClause := "site_id='" + siteID + "' AND start_ip_addr='" + startHexAddr + "' AND subnet_size='" + strconv.Itoa(prefixlengthtosize(prefixSize)) + "'"
+
+	if terminal {
+		whereClause += " AND is_terminal='1'"
+	} else {
+		whereClause += " AND is_terminal='0'"
+	}
+
+	parameters.Add("WHERE", whereClause)
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_block_subnet_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if subnetID, subnetIDExist := buf[0]["subnet_id"].(string); subnetIDExist {
+				return subnetID, nil
+			}
+		}
+
+		return "", nil
+	}
+
+	return "", err
+}
+
+// Return the oid of an IPv6 subnet from site_id, start address, prefix length and is_terminal property
+// Or an empty string if none
+func ip6subnetidbyprefix(siteID string, startHexAddr string, prefixSize int, terminal bool, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	whereClause := "site_id='" + siteID + "' AND start_ip6_addr='" + startHexAddr + "' AND subnet6_prefix='" + strconv.Itoa(prefixSize) + "'"
+
+	if terminal {
+		whereClause += " AND is_terminal='1'"
+	} else {
+		whereClause += " AND is_terminal='0'"
+	}
+
+	parameters.Add("WHERE", whereClause)
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip6_block6_subnet6_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if subnetID, subnetIDExist := buf[0]["subnet6_id"].(string); subnetIDExist {
+				return subnetID, nil
+			}
+		}
+
+		return "", nil
+	}
+
+	return "", err
+}
+
+// Return the oid of the IP pool of a subnet matching exactly the given hexa range
+// Or an empty string if none
+func ippoolidbyrange(subnetID string, startHexAddr string, endHexAddr string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "subnet_id='"+subnetID+"' AND start_ip_addr='"+startHexAddr+"' AND end_ip_addr='"+endHexAddr+"'")
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip_pool_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if poolID, poolIDExist := buf[0]["pool_id"].(string); poolIDExist {
+				return poolID, nil
+			}
+		}
+
+		return "", nil
+	}
+
+	return "", err
+}
+
+// Return the oid of the IPv6 pool of a subnet matching exactly the given hexa range
+// Or an empty string if none
+func ip6poolidbyrange(subnetID string, startHexAddr string, endHexAddr string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", "subnet6_id='"+subnetID+"' AND start_ip6_addr='"+startHexAddr+"' AND end_ip6_addr='"+endHexAddr+"'")
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/ip6_pool6_list", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if poolID, poolIDExist := buf[0]["pool6_id"].(string); poolIDExist {
+				return poolID, nil
+			}
+		}
+
+		return "", nil
+	}
+
+	return "", err
+}
+
+// Compute the gateway of an IP subnet from its hexa start address, prefix length and gateway offset
+// A negative offset is counted backward from the broadcast address
+func ipsubnetgateway(startHexAddr string, prefixSize int, goffset int) string {
+	if goffset > 0 {
+		return longtoip(iptolong(hexiptoip(startHexAddr)) + uint32(goffset))
+	}
+
+	return longtoip(iptolong(hexiptoip(startHexAddr)) + uint32(prefixlengthtosize(prefixSize)) - uint32(abs(goffset)) - 1)
+}
+
+// Compute the gateway of an IPv6 subnet from its hexa start address, prefix length and gateway offset
+// A negative offset is counted backward from the end of the subnet
+func ip6subnetgateway(startHexAddr string, prefixSize int, goffset int) string {
+	bigStartAddr, _ := new(big.Int).SetString(startHexAddr, 16)
+
+	if goffset > 0 {
+		bigOffset := big.NewInt(int64(goffset))
+		return hexip6toip6(BigIntToHexStr(bigStartAddr.Add(bigStartAddr, bigOffset)))
+	}
+
+	bigEndAddr := bigStartAddr.Add(bigStartAddr, prefix6lengthtosize(int64(prefixSize)))
+	bigOffset := big.NewInt(int64(abs(goffset)))
+	return hexip6toip6(BigIntToHexStr(bigEndAddr.Sub(bigEndAddr, bigOffset)))
+}
+
+// Return the oid of the first object listed by a service matching a WHERE clause
+// Or an empty string in case of failure
+func objectidbywhere(service string, idKey string, whereClause string, meta interface{}) (string, error) {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("WHERE", whereClause)
+	parameters.Add("limit", "1")
+
+	// Sending the read request
+	resp, body, err := s.Request("get", "rest/"+service, &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 && len(buf) > 0 {
+			if oid, oidExist := buf[0][idKey].(string); oidExist {
+				return oid, nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Unable to find object from %s WHERE %s\n", service, whereClause)
+
+	return "", err
+}
+
+// Resolve the oid of an object to import from its import ID
+// Numeric import IDs are oids, others are natural keys (ex: space/address) split on '/' and resolved
+func importidresolve(importID string, keyFormat string, objectType string, resolve func(keys []string) (string, error)) (string, error) {
+	if _, err := strconv.ParseUint(importID, 10, 64); err == nil {
+		return importID, nil
+	}
+
+	keys := strings.SplitN(importID, "/", strings.Count(keyFormat, "/")+1)
+
+	if len(keys) != strings.Count(keyFormat, "/")+1 {
+		return "", fmt.Errorf("SOLIDServer - Unable to import %s: %s, expecting an oid or a key like %s\n", objectType, importID, keyFormat)
+	}
+
+	oid, err := resolve(keys)
+
+	if err != nil {
+		// Reporting a failure
+		return "", err
+	}
+
+	if oid == "" {
+		return "", fmt.Errorf("SOLIDServer - Unable to find and import %s: %s\n", objectType, importID)
+	}
+
+	log.Printf("[DEBUG] SOLIDServer - Resolved %s: %s (oid): %s\n", objectType, importID, oid)
+
+	return oid, nil
+}
+
+// Keep a released IP or IPv6 address registered in quarantine for its quarantine period instead of deleting it
+// The quarantine is marked by the quarantine_until class parameter and optionally by a dedicated class
+func ipaddressquarantine(d *schema.ResourceData, v6 bool, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+	var prefix string = "ip"
+	var service string = "rest/ip_add"
+	var label string = "IP address"
+
+	if v6 {
+		prefix = "ip6"
+		service = "rest/ip6_address6_add"
+		label = "IPv6 address"
+	}
+
+	className := d.Get("class").(string)
+	if d.Get("quarantine_class").(string) != "" {
+		className = d.Get("quarantine_class").(string)
+	}
+
+	quarantineUntil := time.Now().Unix() + int64(d.Get("quarantine_period").(int))
+
+	classParameters := urlfromclassparams(d.Get("class_parameters"))
+	classParameters.Set(quarantineClassParameter, strconv.FormatInt(quarantineUntil, 10))
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add(prefix+"_id", d.Id())
+	parameters.Add("add_flag", "edit_only")
+	parameters.Add(prefix+"_class_name", className)
+	parameters.Add(prefix+"_class_parameters", classParameters.Encode())
+
+	// Sending the update request
+	resp, body, err := s.Request("put", service, &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if _, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Quarantined %s (oid): %s until: %s\n", label, d.Id(), time.Unix(quarantineUntil, 0).UTC().Format(time.RFC3339))
+				return nil
+			}
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to quarantine %s: %s (%s)", label, d.Get("name").(string), errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to quarantine %s: %s\n", label, d.Get("name").(string))
+	}
+
+	// Reporting a failure
+	return err
+}
+
+// Register an IP or IPv6 address from the name, device, MAC and class of the resource, trying each candidate address in turn
+// Return the oid and address of the registered address or an error in case of failure
+func ipaddressadd(d *schema.ResourceData, siteID string, deviceID string, addresses []string, v6 bool, meta interface{}) (string, string, error) {
+	s := meta.(*SOLIDserver)
+
+	service := "rest/ip_add"
+	prefix := "ip"
+	family := "IP"
+
+	if v6 {
+		service = "rest/ip6_address6_add"
+		prefix = "ip6"
+		family = "IPv6"
+	}
+
+	for i := 0; i < len(addresses); i++ {
+		// Building parameters
+		parameters := url.Values{}
+		parameters.Add("site_id", siteID)
+		parameters.Add("add_flag", "new_only")
+		parameters.Add(prefix+"_name", d.Get("name").(string))
+		parameters.Add("hostaddr", addresses[i])
+		parameters.Add("hostdev_id", deviceID)
+		parameters.Add(prefix+"_class_name", d.Get("class").(string))
+
+		if d.Get("mac").(string) != "" {
+			parameters.Add("mac_addr", d.Get("mac").(string))
+		}
+
+		// Building class_parameters
+		parameters.Add(prefix+"_class_parameters", urlfromclassparams(d.Get("class_parameters")).Encode())
+
+		// Sending the creation request
+		resp, body, err := s.Request("post", service, &parameters)
+
+		if err != nil {
+			// Reporting a failure
+			return "", "", err
+		}
+
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if (resp.StatusCode == 200 || resp.StatusCode == 201) && len(buf) > 0 {
+			if oid, oidExist := buf[0]["ret_oid"].(string); oidExist {
+				log.Printf("[DEBUG] SOLIDServer - Created %s address (oid): %s\n", family, oid)
+				return oid, addresses[i], nil
+			}
+		}
+
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				log.Printf("[DEBUG] SOLIDServer - Failed %s address registration for: %s with address: %s (%s)\n", family, d.Get("name").(string), addresses[i], errMsg)
+				continue
+			}
+		}
+
+		log.Printf("[DEBUG] SOLIDServer - Failed %s address registration for: %s with address: %s\n", family, d.Get("name").(string), addresses[i])
+	}
+
+	// Reporting a failure
+	return "", "", fmt.Errorf("SOLIDServer - Unable to create %s address: %s, unable to find a suitable network or address\n", family, d.Get("name").(string))
+}
+
+// Delete an IP or IPv6 address from its oid
+// Return an error in case of failure
+func ipaddressdelete(oid string, v6 bool, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	service := "rest/ip_delete"
+	family := "IP"
+
+	// Building parameters
+	parameters := url.Values{}
+
+	if v6 {
+		service = "rest/ip6_address6_delete"
+		family = "IPv6"
+		parameters.Add("ip6_id", oid)
+	} else {
+		parameters.Add("ip_id", oid)
+	}
+
+	// Sending the deletion request
+	resp, body, err := s.Request("delete", service, &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			// Reporting a failure
+			if len(buf) > 0 {
+				if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+					return fmt.Errorf("SOLIDServer - Unable to delete %s address (oid): %s (%s)", family, oid, errMsg)
+				}
+			}
+
+			return fmt.Errorf("SOLIDServer - Unable to delete %s address (oid): %s", family, oid)
+		}
+
+		// Log deletion
+		log.Printf("[DEBUG] SOLIDServer - Deleted %s address's oid: %s\n", family, oid)
+
+		// Reporting a success
+		return nil
+	}
+
+	// Reporting a failure
+	return err
+}
+
+// Delete the quarantined IP or IPv6 addresses of a subnet whose quarantine period is over
+// Quarantined addresses remain registered, the allocation skips them until they are deleted
+// Return an error if some of them can't be listed or released
+func ipaddressreleaseexpired(siteID string, subnetName string, v6 bool, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+	var prefix string = "ip"
+	var subnetKey string = "subnet_id"
+	var listService string = "rest/ip_address_list"
+	var deleteService string = "rest/ip_delete"
+	var subnetID string = ""
+	var subnetErr error = nil
+
+	if v6 {
+		prefix = "ip6"
+		subnetKey = "subnet6_id"
+		listService = "rest/ip6_address6_list"
+		deleteService = "rest/ip6_address6_delete"
+		subnetID, subnetErr = ip6subnetidbyname(siteID, subnetName, true, meta)
+	} else {
+		subnetID, subnetErr = ipsubnetidbyname(siteID, subnetName, true, meta)
+	}
+
+	if subnetID == "" {
+		// Nothing to release, the allocation reports the missing subnet
+		return subnetErr
+	}
+
+	expired := []string{}
+	now := time.Now().Unix()
+
+	for offset := 0; ; offset += listPageSize {
+		// Building parameters
+		parameters := url.Values{}
+		parameters.Add("WHERE", subnetKey+"='"+subnetID+"' AND "+prefix+"_class_parameters LIKE '%"+quarantineClassParameter+"=%'")
+		parameters.Add("offset", strconv.Itoa(offset))
+		parameters.Add("limit", strconv.Itoa(listPageSize))
+
+		// Sending the read request
+		resp, body, err := s.Request("get", listService, &parameters)
+
+		if err != nil {
+			// Reporting a failure
+			return err
+		}
+
+		if resp.StatusCode != 200 && resp.StatusCode != 204 {
+			return fmt.Errorf("SOLIDServer - Unable to list the quarantined addresses of subnet (oid): %s\n", subnetID)
+		}
+
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		for _, address := range buf {
+			addressID, _ := address[prefix+"_id"].(string)
+			rawClassParameters, _ := address[prefix+"_class_parameters"].(string)
+			classParameters, _ := url.ParseQuery(rawClassParameters)
+
+			quarantineUntil, untilErr := strconv.ParseInt(classParameters.Get(quarantineClassParameter), 10, 64)
+			if addressID != "" && untilErr == nil && quarantineUntil <= now {
+				expired = append(expired, addressID)
+			}
+		}
+
+		if len(buf) < listPageSize {
+			break
+		}
+	}
+
+	failed := []string{}
+
+	for _, addressID := range expired {
+		// Building parameters
+		parameters := url.Values{}
+		parameters.Add(prefix+"_id", addressID)
+
+		// Sending the deletion request
+		resp, _, err := s.Request("delete", deleteService, &parameters)
+
+		if err == nil && (resp.StatusCode == 200 || resp.StatusCode == 204) {
+			log.Printf("[DEBUG] SOLIDServer - Released quarantined address (oid): %s\n", addressID)
+		} else {
+			log.Printf("[DEBUG] SOLIDServer - Unable to release quarantined address (oid): %s\n", addressID)
+			failed = append(failed, addressID)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("SOLIDServer - Unable to release quarantined address(es) (oid): %s\n", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// Return the value1 to valueN fields of a RR from its value
+// The value of the structured RRs holds their fields separated by spaces (ex: '10 mail.example.com' for a MX), quoted fields may hold spaces
+// Other values, TXT ones included, are kept as a single field
+func dnsrrvaluefields(rrType string, value string) []string {
+	attributes, structured := dnsrrtypeattributes[strings.ToUpper(rrType)]
+
+	if !structured {
+		return []string{value}
+	}
+
+	// Splitting on the spaces out of the quoted strings, the last field holding the remainder
+	fields := []string{}
+	start := 0
+	quoted := false
+
+	for i := 0; i < len(value) && len(fields) < len(attributes)-1; i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ' ':
+			if !quoted {
+				fields = append(fields, value[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(fields, value[start:])
+}
+
+// Return the value of a RR from its retrieved value1 to valueN fields
+func dnsrrvaluefrominfo(info map[string]interface{}) string {
+	rrType, _ := info["rr_type"].(string)
+
+	if attributes, structured := dnsrrtypeattributes[strings.ToUpper(rrType)]; structured {
+		fields := []string{}
+
+		for i := range attributes {
+			field, _ := info["value"+strconv.Itoa(i+1)].(string)
+			fields = append(fields, field)
+		}
+
+		return strings.Join(fields, " ")
+	}
+
+	value, _ := info["value1"].(string)
+
+	if strings.ToUpper(rrType) == "AAAA" {
+		return longip6toshortip6(value)
+	}
+
+	return value
+}
+
+// Return all the DNS RRs of a name and type from dns_name, dnsview_name and optionally dnszone_name
+// Or an error in case of failure
+func dnsrrlist(serverName string, viewName string, zoneName string, rrName string, rrType string, meta interface{}) ([]map[string]interface{}, error) {
+	s := meta.(*SOLIDserver)
+	rrs := []map[string]interface{}{}
+
+	whereClause := "dns_name='" + serverName + "' AND rr_full_name='" + rrName + "' AND rr_type='" + strings.ToUpper(rrType) + "'"
+
+	if len(viewName) != 0 {
+		whereClause += " AND dnsview_name='" + viewName + "'"
+	} else {
+		whereClause += " AND dnsview_name='#'"
+	}
+
+	if len(zoneName) != 0 {
+		whereClause += " AND dnszone_name='" + zoneName + "'"
+	}
+
+	for offset := 0; ; offset += listPageSize {
+		// Building parameters
+		parameters := url.Values{}
+		parameters.Add("WHERE", whereClause)
+		parameters.Add("offset", strconv.Itoa(offset))
+		parameters.Add("limit", strconv.Itoa(listPageSize))
+
+		// Sending the read request
+		resp, body, err := s.Request("get", "rest/dns_rr_list", &parameters)
+
+		if err != nil {
+			// Reporting a failure
+			return nil, err
+		}
+
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// An empty answer ends the listing
+		if resp.StatusCode == 204 || len(buf) == 0 {
+			break
+		}
+
+		if resp.StatusCode != 200 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return nil, fmt.Errorf("SOLIDServer - Unable to list RRs: %s (%s)\n", rrName, errMsg)
+			}
+
+			return nil, fmt.Errorf("SOLIDServer - Unable to list RRs: %s\n", rrName)
+		}
+
+		rrs = append(rrs, buf...)
+
+		if len(buf) < listPageSize {
+			break
+		}
+	}
+
+	return rrs, nil
+}
+
+// Encode an IP or IPv6 prefix (CIDR) as the labels of a RPZ IP or NSIP trigger
+// Ex: 10.0.0.0/8 => 8.0.0.0.10, 2001:db8::/32 => 32.zz.db8.2001
+func dnsrpzcidrtolabels(cidr string) (string, error) {
+	_, prefix, err := net.ParseCIDR(cidr)
+
+	if err != nil {
+		return "", fmt.Errorf("SOLIDServer - Invalid RPZ IP trigger: %s (%s)", cidr, err)
+	}
+
+	prefixLength, _ := prefix.Mask.Size()
+	labels := []string{}
+
+	if prefix.IP.To4() != nil {
+		labels = strings.Split(prefix.IP.To4().String(), ".")
+	} else {
+		// The longest run of zero fields is replaced by 'zz' the same way it is by '::' in IPv6 addresses
+		labels = strings.Split(strings.Trim(strings.Replace(prefix.IP.String(), "::", ":zz:", 1), ":"), ":")
+	}
+
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+
+	return strconv.Itoa(prefixLength) + "." + strings.Join(labels, "."), nil
+}
+
+// Decode the labels of a RPZ IP or NSIP trigger as an IP or IPv6 prefix (CIDR)
+// Return an empty string if the labels aren't a valid trigger
+func dnsrpzlabelstocidr(triggerLabels string) string {
+	labels := strings.Split(triggerLabels, ".")
+
+	if len(labels) < 2 {
+		return ""
+	}
+
+	fields := labels[1:]
+
+	for i, j := 0, len(fields)-1; i < j; i, j = i+1, j-1 {
+		fields[i], fields[j] = fields[j], fields[i]
+	}
+
+	address := strings.Join(fields, ".")
+
+	if zz := stringOffsetInSlice("zz", fields); zz != -1 {
+		address = strings.Join(fields[:zz], ":") + "::" + strings.Join(fields[zz+1:], ":")
+	} else if len(fields) != 4 {
+		address = strings.Join(fields, ":")
+	}
+
+	if _, prefix, err := net.ParseCIDR(address + "/" + labels[0]); err == nil {
+		return prefix.String()
+	}
+
+	return ""
+}
+
+// Compute the name of the RR implementing a RPZ rule from its trigger, the value to match and the RPZ zone name
+func dnsrpzrulename(trigger string, match string, zoneName string) (string, error) {
+	trigger = strings.ToUpper(trigger)
+	owner := strings.TrimSuffix(match, ".")
+
+	if trigger == "IP" || trigger == "NSIP" {
+		labels, err := dnsrpzcidrtolabels(match)
+
+		if err != nil {
+			return "", err
+		}
+
+		owner = labels
+	}
+
+	if label, labelExist := dnsrpztriggerlabels[trigger]; labelExist {
+		owner += "." + label
+	}
+
+	return owner + "." + strings.TrimSuffix(zoneName, "."), nil
+}
+
+// Retrieve the trigger and the value to match of a RPZ rule from the name of the RR implementing it
+func dnsrpzruletrigger(rrName string, zoneName string) (string, string) {
+	owner := strings.TrimSuffix(strings.TrimSuffix(rrName, "."), "."+strings.TrimSuffix(zoneName, "."))
+
+	for trigger, label := range dnsrpztriggerlabels {
+		if strings.HasSuffix(owner, "."+label) {
+			owner = strings.TrimSuffix(owner, "."+label)
+
+			if trigger == "NSDNAME" {
+				return trigger, owner
+			}
+
+			return trigger, dnsrpzlabelstocidr(owner)
+		}
+	}
+
+	return "QNAME", owner
+}
+
+// Retrieve the action and the redirection target of a RPZ rule from the value of the CNAME implementing it
+func dnsrpzruleaction(value string) (string, string) {
+	for action, actionValue := range dnsrpzactionvalues {
+		if value == actionValue || value+"." == actionValue {
+			return action, ""
+		}
+	}
+
+	return "CNAME", strings.TrimSuffix(value, ".")
+}
+
+// Enable the DNSSEC signing of a DNS zone, or update its keys configuration if it is already signed
+// Return an error in case of failure
+func dnszonednssecsign(zoneID string, zoneName string, dnssec map[string]interface{}, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnszone_id", zoneID)
+	parameters.Add("ksk_algorithm", strings.ToUpper(dnssec["ksk_algorithm"].(string)))
+	parameters.Add("ksk_size", strconv.Itoa(dnssec["ksk_size"].(int)))
+	parameters.Add("ksk_rollover_period", strconv.Itoa(dnssec["ksk_rollover"].(int)))
+	parameters.Add("zsk_algorithm", strings.ToUpper(dnssec["zsk_algorithm"].(string)))
+	parameters.Add("zsk_size", strconv.Itoa(dnssec["zsk_size"].(int)))
+	parameters.Add("zsk_rollover_period", strconv.Itoa(dnssec["zsk_rollover"].(int)))
+
+	// Sending the signing request
+	resp, body, err := s.Request("put", "rest/dns_zone_sign", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 || resp.StatusCode == 201 || resp.StatusCode == 204 {
+			log.Printf("[DEBUG] SOLIDServer - Signed DNS zone: %s\n", zoneName)
+			return nil
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to sign DNS zone: %s (%s)", zoneName, errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to sign DNS zone: %s\n", zoneName)
+	}
+
+	// Reporting a failure
+	return err
+}
+
+// Disable the DNSSEC signing of a DNS zone, removing its keys
+// Return an error in case of failure
+func dnszonednssecunsign(zoneID string, zoneName string, meta interface{}) error {
+	s := meta.(*SOLIDserver)
+
+	// Building parameters
+	parameters := url.Values{}
+	parameters.Add("dnszone_id", zoneID)
+
+	// Sending the unsigning request
+	resp, body, err := s.Request("put", "rest/dns_zone_unsign", &parameters)
+
+	if err == nil {
+		var buf [](map[string]interface{})
+		json.Unmarshal([]byte(body), &buf)
+
+		// Checking the answer
+		if resp.StatusCode == 200 || resp.StatusCode == 201 || resp.StatusCode == 204 {
+			log.Printf("[DEBUG] SOLIDServer - Unsigned DNS zone: %s\n", zoneName)
+			return nil
+		}
+
+		// Reporting a failure
+		if len(buf) > 0 {
+			if errMsg, errExist := buf[0]["errmsg"].(string); errExist {
+				return fmt.Errorf("SOLIDServer - Unable to unsign DNS zone: %s (%s)", zoneName, errMsg)
+			}
+		}
+
+		return fmt.Errorf("SOLIDServer - Unable to unsign DNS zone: %s\n", zoneName)
+	}
+
+	// Reporting a failure
+	return err
+}
+
+// Encode a domain name in the canonical wire format (RFC 4034)
+func dnsnametowire(name string) []byte {
+	wire := []byte{}
+
+	for _, label := range strings.Split(strings.Trim(strings.ToLower(name), "."), ".") {
+		if label != "" {
+			wire = append(wire, byte(len(label)))
+			wire = append(wire, []byte(label)...)
+		}
+	}
+
+	return append(wire, 0)
+}
+
+// Compute the key tag of a DNSKEY from its RDATA (RFC 4034 Appendix B)
+func dnskeytag(rdata []byte) int {
+	var ac uint32 = 0
+
+	for i, b := range rdata {
+		if i&1 == 1 {
+			ac += uint32(b)
+		} else {
+			ac += uint32(b) << 8
+		}
+	}
+
+	ac += (ac >> 16) & 0xFFFF
+
+	return int(ac & 0xFFFF)
+}
+
+// Compute the SHA-256 digest of the DS record of a DNSKEY from its owner name and RDATA (RFC 4509)
+func dnskeydsdigest(zoneName string, rdata []byte) string {
+	digest := sha256.Sum256(append(dnsnametowire(zoneName), rdata...))
+
+	return strings.ToUpper(hex.EncodeToString(digest[:]))
+}
+
+// Compute the SHA-256 DS records of the key signing keys (SEP flag) published in a signed DNS zone
+// Return the DS records or an error in case of failure
+func dnszonedsrecords(serverName string, viewName string, zoneName string, meta interface{}) ([]interface{}, error) {
+	records := []interface{}{}
+
+	if viewName == "#" {
+		viewName = ""
+	}
+
+	dnskeys, err := dnsrrlist(serverName, viewName, zoneName, zoneName, "DNSKEY", meta)
+
+	if err != nil {
+		// Reporting a failure
+		return nil, err
+	}
+
+	for _, dnskey := range dnskeys {
+		flags, _ := strconv.Atoi(fmt.Sprint(dnskey["value1"]))
+		protocol, _ := strconv.Atoi(fmt.Sprint(dnskey["value2"]))
+		algorithm, _ := strconv.Atoi(fmt.Sprint(dnskey["value3"]))
+		publicKey, keyErr := base64.StdEncoding.DecodeString(strings.ReplaceAll(fmt.Sprint(dnskey["value4"]), " ", ""))
+
+		// Only the key signing keys are referred to by the parent zone
+		if flags&1 == 0 || keyErr != nil {
+			continue
+		}
+
+		rdata := make([]byte, 4)
+		binary.BigEndian.PutUint16(rdata, uint16(flags))
+		rdata[2] = byte(protocol)
+		rdata[3] = byte(algorithm)
+		rdata = append(rdata, publicKey...)
+
+		keyTag := dnskeytag(rdata)
+		hexDigest := dnskeydsdigest(zoneName, rdata)
+
+		records = append(records, map[string]interface{}{
+			"key_tag":     keyTag,
+			"algorithm":   algorithm,
+			"digest_type": 2,
+			"digest":      hexDigest,
+			"value":       fmt.Sprintf("%d %d 2 %s", keyTag, algorithm, hexDigest),
+		})
+	}
+
+	return records, nil
+}
+
+// Check that an ACL entry is either a network prefix, a TSIG key reference (ex: 'key xfr-key')
+// or a named ACL reference (ex: 'internal', including the built-in any, none, localhost and localnets)
+func dnsaclmatch(entry string) (bool, error) {
+	if match, err := regexp.MatchString(regexpNetworkAcl, entry); match || err != nil {
+		return match, err
+	}
+
+	if match, err := regexp.MatchString(regexpKeyAcl, entry); match || err != nil {
+		return match, err
+	}
+
+	return regexp.MatchString(regexpNamedAcl, entry)
+}
+
+// Compute the names of the in-addr.arpa or ip6.arpa reverse zones covering an IP or IPv6 prefix (CIDR)
+// Prefixes that aren't octet (IPv4) or nibble (IPv6) aligned are split into the aligned zones they cover,
+// except the IPv4 prefixes longer than /24 using a RFC 2317 classless zone (ex: 0/26.2.0.192.in-addr.arpa)
+func dnsreversezonenames(cidr string) ([]string, error) {
+	_, prefix, err := net.ParseCIDR(cidr)
+
+	if err != nil {
+		return nil, fmt.Errorf("SOLIDServer - Invalid prefix: %s (%s)", cidr, err)
+	}
+
+	prefixLength, bits := prefix.Mask.Size()
+	address := prefix.IP.To16()
+	step := 4
+
+	if bits == 32 {
+		address = prefix.IP.To4()
+		step = 8
+
+		if prefixLength > 24 {
+			labels := strings.Split(iptoptr(address.String()), ".")
+			return []string{labels[0] + "/" + strconv.Itoa(prefixLength) + "." + strings.Join(labels[1:], ".")}, nil
+		}
+	}
+
+	alignedLength := ((prefixLength + step - 1) / step) * step
+	if alignedLength == 0 {
+		alignedLength = step
+	}
+
+	count := 1 << uint(alignedLength-prefixLength)
+	if count > reverseZoneMaxCount {
+		return nil, fmt.Errorf("SOLIDServer - Prefix: %s requires %d reverse zones (maximum: %d)", cidr, count, reverseZoneMaxCount)
+	}
+
+	zones := []string{}
+	base := new(big.Int).SetBytes(address)
+
+	for i := 0; i < count; i++ {
+		current := new(big.Int).Add(base, new(big.Int).Lsh(big.NewInt(int64(i)), uint(bits-alignedLength)))
+		buf := make([]byte, bits/8)
+		current.FillBytes(buf)
+
+		if bits == 32 {
+			labels := strings.Split(iptoptr(net.IP(buf).String()), ".")
+			zones = append(zones, strings.Join(labels[len(labels)-2-alignedLength/8:], "."))
+		} else {
+			zones = append(zones, ip6reversezonename(hex.EncodeToString(buf), alignedLength))
+		}
+	}
+
+	return zones, nil
+}
+
+// Compute the RFC 2317 CNAMEs to create in the octet aligned parent zone of a classless IPv4 reverse zone
+// Return the parent zone name and the CNAMEs indexed by their name
+func dnsclasslesscnames(cidr string) (string, map[string]string) {
+	cnames := make(map[string]string)
+	_, prefix, err := net.ParseCIDR(cidr)
+
+	if err != nil || prefix.IP.To4() == nil {
+		return "", cnames
+	}
+
+	prefixLength, _ := prefix.Mask.Size()
+	if prefixLength <= 24 {
+		return "", cnames
+	}
+
+	zones, _ := dnsreversezonenames(cidr)
+	labels := strings.Split(iptoptr(prefix.IP.To4().String()), ".")
+	parentZone := strings.Join(labels[1:], ".")
+	first, _ := strconv.Atoi(labels[0])
+
+	for host := first; host < first+(1<<uint(32-prefixLength)); host++ {
+		cnames[strconv.Itoa(host)+"."+parentZone] = strconv.Itoa(host) + "." + zones[0]
+	}
+
+	return parentZone, cnames
+}
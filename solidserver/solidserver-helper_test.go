@@ -0,0 +1,343 @@
+package solidserver
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_mactoeui64(t *testing.T) {
+	tests := []struct {
+		mac  string
+		want string
+	}{
+		{"00:1A:2b:3c:4d:5e", "021a2bfffe3c4d5e"},
+		{"02-00-00-00-00-01", "000000fffe000001"},
+		{"52:54:00:12:34:56", "505400fffe123456"},
+		{"00:11:22", ""},
+		{"zz:11:22:33:44:55", ""},
+	}
+
+	for _, test := range tests {
+		if got := mactoeui64(test.mac); got != test.want {
+			t.Errorf("mactoeui64(%q) = %q, want %q", test.mac, got, test.want)
+		}
+	}
+}
+
+func Test_ipsubnetgateway(t *testing.T) {
+	tests := []struct {
+		startHexAddr string
+		prefixSize   int
+		goffset      int
+		want         string
+	}{
+		{"0a000000", 24, 1, "10.0.0.1"},
+		{"0a000000", 24, -1, "10.0.0.254"},
+		{"0a000000", 8, -2, "10.255.255.253"},
+		{"c0a80140", 26, 10, "192.168.1.74"},
+		{"c0a80140", 26, -1, "192.168.1.126"},
+	}
+
+	for _, test := range tests {
+		if got := ipsubnetgateway(test.startHexAddr, test.prefixSize, test.goffset); got != test.want {
+			t.Errorf("ipsubnetgateway(%q, %d, %d) = %q, want %q", test.startHexAddr, test.prefixSize, test.goffset, got, test.want)
+		}
+	}
+}
+
+func Test_importidresolve(t *testing.T) {
+	resolve := func(keys []string) (string, error) {
+		switch keys[0] {
+		case "unknown":
+			return "", nil
+		case "failing":
+			return "", fmt.Errorf("lookup failure")
+		}
+
+		return strings.Join(keys, "|"), nil
+	}
+
+	tests := []struct {
+		importID string
+		want     string
+		wantErr  bool
+	}{
+		{"42", "42", false},
+		{"my_space/10.0.0.0/24", "my_space|10.0.0.0|24", false},
+		{"my_space/2001:db8::/64/extra", "my_space|2001:db8::|64/extra", false},
+		{"my_space/10.0.0.0", "", true},
+		{"unknown/10.0.0.0/24", "", true},
+		{"failing/10.0.0.0/24", "", true},
+	}
+
+	for _, test := range tests {
+		got, err := importidresolve(test.importID, "space/address/prefix_size", "IP subnet", resolve)
+
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("importidresolve(%q) = %q, %v, want %q (error: %t)", test.importID, got, err, test.want, test.wantErr)
+		}
+	}
+}
+
+func Test_dnsrpzcidrtolabels(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		want    string
+		wantErr bool
+	}{
+		{"10.0.0.0/8", "8.0.0.0.10", false},
+		{"192.0.2.0/24", "24.0.2.0.192", false},
+		{"192.0.2.1/32", "32.1.2.0.192", false},
+		{"2001:db8::/32", "32.zz.db8.2001", false},
+		{"2001:db8:0:0:1::/80", "80.zz.1.0.0.db8.2001", false},
+		{"10.0.0.0", "", true},
+	}
+
+	for _, test := range tests {
+		got, err := dnsrpzcidrtolabels(test.cidr)
+
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("dnsrpzcidrtolabels(%q) = %q, %v, want %q (error: %t)", test.cidr, got, err, test.want, test.wantErr)
+		}
+	}
+}
+
+func Test_dnsrpzlabelstocidr(t *testing.T) {
+	tests := []struct {
+		labels string
+		want   string
+	}{
+		{"8.0.0.0.10", "10.0.0.0/8"},
+		{"32.1.2.0.192", "192.0.2.1/32"},
+		{"32.zz.db8.2001", "2001:db8::/32"},
+		{"80.zz.1.0.0.db8.2001", "2001:db8:0:0:1::/80"},
+		{"24", ""},
+		{"24.2.0.192", ""},
+		{"mal.ware", ""},
+	}
+
+	for _, test := range tests {
+		if got := dnsrpzlabelstocidr(test.labels); got != test.want {
+			t.Errorf("dnsrpzlabelstocidr(%q) = %q, want %q", test.labels, got, test.want)
+		}
+	}
+}
+
+func Test_dnsrpzrulename(t *testing.T) {
+	tests := []struct {
+		trigger string
+		match   string
+		want    string
+	}{
+		{"QNAME", "*.malware.example", "*.malware.example.rpz.local"},
+		{"NSDNAME", "ns.malware.example.", "ns.malware.example.rpz-nsdname.rpz.local"},
+		{"IP", "192.0.2.0/24", "24.0.2.0.192.rpz-ip.rpz.local"},
+		{"nsip", "2001:db8::/32", "32.zz.db8.2001.rpz-nsip.rpz.local"},
+	}
+
+	for _, test := range tests {
+		got, err := dnsrpzrulename(test.trigger, test.match, "rpz.local.")
+
+		if err != nil || got != test.want {
+			t.Errorf("dnsrpzrulename(%q, %q) = %q, %v, want %q", test.trigger, test.match, got, err, test.want)
+			continue
+		}
+
+		// The trigger and the value to match are retrieved back from the name of the RR
+		trigger, match := dnsrpzruletrigger(got, "rpz.local")
+
+		if trigger != strings.ToUpper(test.trigger) || match != strings.TrimSuffix(test.match, ".") {
+			t.Errorf("dnsrpzruletrigger(%q) = %q, %q, want %q, %q", got, trigger, match, strings.ToUpper(test.trigger), strings.TrimSuffix(test.match, "."))
+		}
+	}
+}
+
+// The DNSKEY of dskey.example.com from RFC 4034 section 5.4 and RFC 4509 section 2.3
+func testdnskeyrdata(t *testing.T) []byte {
+	publicKey, err := base64.StdEncoding.DecodeString("AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZ" +
+		"DRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9Xzc" +
+		"nOf+EPbtG9DMBmADjFDc2w/rljwvFw==")
+
+	if err != nil {
+		t.Fatalf("Unable to decode the public key (%s)", err)
+	}
+
+	rdata := make([]byte, 4)
+	binary.BigEndian.PutUint16(rdata, 256)
+	rdata[2] = 3
+	rdata[3] = 5
+
+	return append(rdata, publicKey...)
+}
+
+func Test_dnsnametowire(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"dskey.example.com", "\x05dskey\x07example\x03com\x00"},
+		{"DSKEY.Example.COM.", "\x05dskey\x07example\x03com\x00"},
+		{".", "\x00"},
+	}
+
+	for _, test := range tests {
+		if got := string(dnsnametowire(test.name)); got != test.want {
+			t.Errorf("dnsnametowire(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func Test_dnskeytag(t *testing.T) {
+	if got := dnskeytag(testdnskeyrdata(t)); got != 60485 {
+		t.Errorf("dnskeytag() = %d, want 60485", got)
+	}
+}
+
+func Test_dnskeydsdigest(t *testing.T) {
+	want := "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"
+
+	for _, zoneName := range []string{"dskey.example.com", "dskey.example.com."} {
+		if got := dnskeydsdigest(zoneName, testdnskeyrdata(t)); got != want {
+			t.Errorf("dnskeydsdigest(%q) = %q, want %q", zoneName, got, want)
+		}
+	}
+}
+
+func Test_dnsreversezonenames(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		want    []string
+		wantErr bool
+	}{
+		{"10.0.0.0/8", []string{"10.in-addr.arpa"}, false},
+		{"192.0.2.0/24", []string{"2.0.192.in-addr.arpa"}, false},
+		{"10.0.0.0/23", []string{"0.0.10.in-addr.arpa", "1.0.10.in-addr.arpa"}, false},
+		{"10.0.0.0/7", []string{"10.in-addr.arpa", "11.in-addr.arpa"}, false},
+		{"192.0.2.64/26", []string{"64/26.2.0.192.in-addr.arpa"}, false},
+		{"2001:db8::/32", []string{"8.b.d.0.1.0.0.2.ip6.arpa"}, false},
+		{"2001:db8::/31", []string{"8.b.d.0.1.0.0.2.ip6.arpa", "9.b.d.0.1.0.0.2.ip6.arpa"}, false},
+		{"2001:db8:1200::/40", []string{"2.1.8.b.d.0.1.0.0.2.ip6.arpa"}, false},
+		{"10.0.0.0", nil, true},
+	}
+
+	for _, test := range tests {
+		got, err := dnsreversezonenames(test.cidr)
+
+		if (err != nil) != test.wantErr || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("dnsreversezonenames(%q) = %v, %v, want %v (error: %t)", test.cidr, got, err, test.want, test.wantErr)
+		}
+	}
+}
+
+func Test_dnsclasslesscnames(t *testing.T) {
+	tests := []struct {
+		cidr       string
+		wantParent string
+		want       map[string]string
+	}{
+		{"192.0.2.64/30", "2.0.192.in-addr.arpa", map[string]string{
+			"64.2.0.192.in-addr.arpa": "64.64/30.2.0.192.in-addr.arpa",
+			"65.2.0.192.in-addr.arpa": "65.64/30.2.0.192.in-addr.arpa",
+			"66.2.0.192.in-addr.arpa": "66.64/30.2.0.192.in-addr.arpa",
+			"67.2.0.192.in-addr.arpa": "67.64/30.2.0.192.in-addr.arpa",
+		}},
+		{"192.0.2.0/24", "", map[string]string{}},
+		{"2001:db8::/64", "", map[string]string{}},
+	}
+
+	for _, test := range tests {
+		parent, got := dnsclasslesscnames(test.cidr)
+
+		if parent != test.wantParent || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("dnsclasslesscnames(%q) = %q, %v, want %q, %v", test.cidr, parent, got, test.wantParent, test.want)
+		}
+	}
+}
+
+func Test_ip6reversezonename(t *testing.T) {
+	tests := []struct {
+		hexAddr      string
+		prefixLength int
+		want         string
+	}{
+		{"20010db8000000000000000000000000", 32, "8.b.d.0.1.0.0.2.ip6.arpa"},
+		{"20010db8123400000000000000000000", 48, "4.3.2.1.8.b.d.0.1.0.0.2.ip6.arpa"},
+		{"20010db8123456700000000000000000", 60, "7.6.5.4.3.2.1.8.b.d.0.1.0.0.2.ip6.arpa"},
+		{"20010db8123400000000000000000000", 50, ""},
+	}
+
+	for _, test := range tests {
+		if got := ip6reversezonename(test.hexAddr, test.prefixLength); got != test.want {
+			t.Errorf("ip6reversezonename(%q, %d) = %q, want %q", test.hexAddr, test.prefixLength, got, test.want)
+		}
+	}
+}
+
+func Test_dnsrrvaluefields(t *testing.T) {
+	tests := []struct {
+		rrType string
+		value  string
+		want   []string
+	}{
+		{"A", "10.0.0.10", []string{"10.0.0.10"}},
+		{"TXT", "v=spf1 mx -all", []string{"v=spf1 mx -all"}},
+		{"mx", "10 mail.example.com", []string{"10", "mail.example.com"}},
+		{"SRV", "0 5 5060 sip.example.com", []string{"0", "5", "5060", "sip.example.com"}},
+		{"CAA", "0 issue \"ca.example.net; account=42\"", []string{"0", "issue", "\"ca.example.net; account=42\""}},
+		{"NAPTR", "100 10 \"S\" \"SIP+D2U\" \"!^.*$!sip:info@example.com!\" _sip._udp.example.com", []string{"100", "10", "\"S\"", "\"SIP+D2U\"", "\"!^.*$!sip:info@example.com!\"", "_sip._udp.example.com"}},
+		{"NAPTR", `100 10 "u" "E2U+sip" "!^(.*) \"x y\"$!\\1!" .`, []string{"100", "10", `"u"`, `"E2U+sip"`, `"!^(.*) \"x y\"$!\\1!"`, "."}},
+	}
+
+	for _, test := range tests {
+		if got := dnsrrvaluefields(test.rrType, test.value); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("dnsrrvaluefields(%q, %q) = %q, want %q", test.rrType, test.value, got, test.want)
+		}
+	}
+}
+
+func Test_dnsaclmatch(t *testing.T) {
+	tests := []struct {
+		entry string
+		want  bool
+	}{
+		{"10.0.0.0/8", true},
+		{"!10.66.0.0/16", true},
+		{"key xfr-key", true},
+		{"!key xfr-key", true},
+		{"internal", true},
+		{"localnets", true},
+		{"10.0.0.1", false},
+		{"key ", false},
+		{"key -bad", false},
+		{"2001:db8::/32", false},
+	}
+
+	for _, test := range tests {
+		if got, err := dnsaclmatch(test.entry); err != nil || got != test.want {
+			t.Errorf("dnsaclmatch(%q) = %t, %v, want %t", test.entry, got, err, test.want)
+		}
+	}
+}
+
+func Test_resourcediffsuppressfqdn(t *testing.T) {
+	tests := []struct {
+		old  string
+		new  string
+		want bool
+	}{
+		{"*.malware.example", "*.malware.example.", true},
+		{"*.Malware.Example.", "*.malware.example", true},
+		{"malware.example", "malware.example", true},
+		{"malware.example", "other.example.", false},
+	}
+
+	for _, test := range tests {
+		if got := resourcediffsuppressfqdn("match", test.old, test.new, nil); got != test.want {
+			t.Errorf("resourcediffsuppressfqdn(%q, %q) = %t, want %t", test.old, test.new, got, test.want)
+		}
+	}
+}